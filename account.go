@@ -1,12 +1,11 @@
 package stripe
 
 import (
-	"encoding/json"
-	"fmt"
 	"net"
 	"net/url"
 	"strconv"
-	"time"
+
+	"github.com/channelmeter/stripe-go/form"
 )
 
 // LegalEntityType describes the types for a legal entity.
@@ -38,18 +37,59 @@ const (
 // AccountParams are the parameters allowed during account creation/updates.
 type AccountParams struct {
 	Params
-	Country, Email, DefaultCurrency, Statement, BusinessName, SupportPhone string
-	LegalEntity                                                            *LegalEntity
-	TransferSchedule                                                       *TransferScheduleParams
-	Managed                                                                bool
-	BankAccount                                                            *BankAccountParams
-	TosAcceptance                                                          *TosAcceptanceParams
+	Country          string                  `form:"country"`
+	Email            string                  `form:"email"`
+	DefaultCurrency  string                  `form:"default_currency"`
+	Statement        string                  `form:"statement_descriptor"`
+	BusinessName     string                  `form:"business_name"`
+	SupportPhone     string                  `form:"support_phone"`
+	LegalEntity      *LegalEntity            `form:"legal_entity"`
+	TransferSchedule *TransferScheduleParams `form:"transfer_schedule"`
+	Managed          bool                    `form:"managed"`
+	BankAccount      *BankAccountParams      `form:"bank_account"`
+	TosAcceptance    *TosAcceptanceParams    `form:"tos_acceptance"`
+}
+
+// Validate checks the account parameters before they are sent.
+func (p *AccountParams) Validate() error {
+	v := &validation{}
+	p.Params.validate(v)
+	v.statement("statement_descriptor", p.Statement, maxStatementLen)
+
+	if p.TransferSchedule != nil {
+		v.nested(p.TransferSchedule.Validate())
+	}
+
+	if p.BankAccount != nil {
+		v.nested(p.BankAccount.Validate())
+	}
+
+	if p.TosAcceptance != nil {
+		v.nested(p.TosAcceptance.Validate())
+	}
+
+	if p.LegalEntity != nil {
+		v.oneOf("legal_entity[type]", string(p.LegalEntity.Type), string(Individual), string(Company))
+	}
+
+	return v.err()
 }
 
 type TosAcceptanceParams struct {
-	Date      time.Time `json:"date"`
-	Ip        net.IP    `json:"ip"`
-	UserAgent string    `json:"user_agent"`
+	Date      Timestamp `json:"date" form:"date"`
+	Ip        net.IP    `json:"ip" form:"ip"`
+	UserAgent string    `json:"user_agent" form:"user_agent"`
+}
+
+// Validate checks that the terms of service acceptance is complete.
+func (t *TosAcceptanceParams) Validate() error {
+	v := &validation{}
+
+	if t.Date.IsZero() != (t.Ip == nil) {
+		v.add("tos_acceptance", "date and ip must be set together")
+	}
+
+	return v.err()
 }
 
 // AccountListParams are the parameters allowed during account listing.
@@ -65,9 +105,42 @@ type TransferScheduleParams struct {
 	MinimumDelay       bool
 }
 
+// Validate checks that the anchors and delay match the schedule's interval.
+func (t *TransferScheduleParams) Validate() error {
+	v := &validation{}
+	v.required("transfer_schedule[interval]", string(t.Interval))
+	v.oneOf("transfer_schedule[interval]", string(t.Interval), string(Manual), string(Day), string(Week), string(Month))
+
+	if len(t.WeekAnchor) > 0 {
+		if t.Interval != Week {
+			v.add("transfer_schedule[weekly_anchor]", "can only be used with a weekly interval")
+		}
+
+		v.oneOf("transfer_schedule[weekly_anchor]", t.WeekAnchor,
+			"monday", "tuesday", "wednesday", "thursday", "friday", "saturday", "sunday")
+	}
+
+	if t.MonthAnchor > 0 {
+		if t.Interval != Month {
+			v.add("transfer_schedule[monthly_anchor]", "can only be used with a monthly interval")
+		}
+
+		if t.MonthAnchor > 31 {
+			v.add("transfer_schedule[monthly_anchor]", "must be between 1 and 31")
+		}
+	}
+
+	if t.Delay > 0 && t.MinimumDelay {
+		v.add("transfer_schedule[delay_days]", "cannot be set when MinimumDelay is used")
+	}
+
+	return v.err()
+}
+
 // Account is the resource representing youe Stripe account.
 // For more details see https://stripe.com/docs/api/#account.
 type Account struct {
+	Extra
 	ID             string `json:"id"`
 	ChargesEnabled bool   `json:"charges_enabled"`
 	Country        string `json:"country"`
@@ -90,61 +163,62 @@ type Account struct {
 		Publish string `json:"publishable"`
 	} `json:"keys"`
 	Verification *struct {
-		Fields    []string `json:"fields_needed"`
-		Due       *int64   `json:"due_by"`
-		Contacted bool     `json:"contacted"`
+		Fields    []string   `json:"fields_needed"`
+		Due       *Timestamp `json:"due_by"`
+		Contacted bool       `json:"contacted"`
 	} `json:"verification"`
 	LegalEntity      *LegalEntity      `json:"legal_entity"`
 	TransferSchedule *TransferSchedule `json:"transfer_schedule"`
 	BankAccounts     *BankAccountList  `json:"bank_accounts"`
 	TosAcceptance    *struct {
-		Date      int64  `json:"date"`
-		IP        string `json:"ip"`
-		UserAgent string `json:"user_agent"`
+		Date      Timestamp `json:"date"`
+		IP        string    `json:"ip"`
+		UserAgent string    `json:"user_agent"`
 	} `json:"tos_acceptance"`
+	Expanded bool `json:"-"`
 }
 
 // LegalEntity is the structure for properties related to an account's legal state.
 type LegalEntity struct {
-	Type             LegalEntityType      `json:"type"`
-	BusinessName     string               `json:"business_name"`
-	Address          Address              `json:"address"`
-	First            string               `json:"first_name"`
-	Last             string               `json:"last_name"`
-	PersonalAddress  Address              `json:"personal_address"`
-	DOB              DOB                  `json:"dob"`
-	AdditionalOwners []Owner              `json:"additional_owners"`
-	Verification     IdentityVerification `json:"verification"`
-	SSN              string               `json:"ssn_last_4"`
-	PersonalID       string               `json:"personal_id_number"`
-	BusinessTaxID    string               `json:"business_tax_id"`
-	BusinessVatID    string               `json:"business_vat_id"`
+	Type             LegalEntityType      `json:"type" form:"type"`
+	BusinessName     string               `json:"business_name" form:"business_name"`
+	Address          Address              `json:"address" form:"address"`
+	First            string               `json:"first_name" form:"first_name"`
+	Last             string               `json:"last_name" form:"last_name"`
+	PersonalAddress  Address              `json:"personal_address" form:"personal_address"`
+	DOB              DOB                  `json:"dob" form:"dob"`
+	AdditionalOwners []Owner              `json:"additional_owners" form:"additional_owners"`
+	Verification     IdentityVerification `json:"verification" form:"-"`
+	SSN              string               `json:"ssn_last_4" form:"ssn_last_4"`
+	PersonalID       string               `json:"personal_id_number" form:"personal_id_number"`
+	BusinessTaxID    string               `json:"business_tax_id" form:"business_tax_id"`
+	BusinessVatID    string               `json:"business_vat_id" form:"business_vat_id"`
 }
 
 // Address is the structure for an account address.
 type Address struct {
-	Line1   string `json:"line1"`
-	Line2   string `json:"line2"`
-	City    string `json:"city"`
-	State   string `json:"state"`
-	Zip     string `json:"postal_code"`
-	Country string `json:"country"`
+	Line1   string `json:"line1" form:"line1"`
+	Line2   string `json:"line2" form:"line2"`
+	City    string `json:"city" form:"city"`
+	State   string `json:"state" form:"state"`
+	Zip     string `json:"postal_code" form:"postal_code"`
+	Country string `json:"country" form:"country"`
 }
 
 // DOB is a structure for an account owner's date of birth.
 type DOB struct {
-	Day   int `json:"day"`
-	Month int `json:"month"`
-	Year  int `json:"year"`
+	Day   int `json:"day" form:"day"`
+	Month int `json:"month" form:"month"`
+	Year  int `json:"year" form:"year"`
 }
 
 // Owner is the structure for an account owner.
 type Owner struct {
-	First        string               `json:"first_name"`
-	Last         string               `json:"last_name"`
-	DOB          DOB                  `json:"dob"`
-	Address      Address              `json:"address"`
-	Verification IdentityVerification `json:"verification"`
+	First        string               `json:"first_name" form:"first_name"`
+	Last         string               `json:"last_name" form:"last_name"`
+	DOB          DOB                  `json:"dob" form:"dob"`
+	Address      Address              `json:"address" form:"address"`
+	Verification IdentityVerification `json:"verification" form:"-"`
 }
 
 // IdentityVerification is the structure for an account's verification.
@@ -155,9 +229,11 @@ type IdentityVerification struct {
 }
 
 type IdentityDocument struct {
-	ID      string `json:"id"`
-	Created int64  `json:"created"`
-	Size    int64  `json:"size"`
+	Extra
+	ID       string    `json:"id"`
+	Created  Timestamp `json:"created"`
+	Size     int64     `json:"size"`
+	Expanded bool      `json:"-"`
 }
 
 // TransferSchedule is the structure for an account's transfer schedule.
@@ -168,154 +244,22 @@ type TransferSchedule struct {
 	MonthAnchor uint64   `json:"monthly_anchor"`
 }
 
-// AppendDetails adds the legal entity to the query string.
-func (l *LegalEntity) AppendDetails(values *url.Values) {
-	values.Add("legal_entity[type]", string(l.Type))
-
-	if len(l.BusinessName) > 0 {
-		values.Add("legal_entity[business_name]", l.BusinessName)
-	}
-
-	if len(l.First) > 0 {
-		values.Add("legal_entity[first_name]", l.First)
-	}
-
-	if len(l.Last) > 0 {
-		values.Add("legal_entity[last_name]", l.Last)
-	}
-
-	values.Add("legal_entity[dob][day]", strconv.Itoa(l.DOB.Day))
-	values.Add("legal_entity[dob][month]", strconv.Itoa(l.DOB.Month))
-	values.Add("legal_entity[dob][year]", strconv.Itoa(l.DOB.Year))
-
-	if len(l.SSN) > 0 {
-		values.Add("legal_entity[ssn_last_4]", l.SSN)
-	}
-
-	if len(l.PersonalID) > 0 {
-		values.Add("legal_entity[personal_id_number]", l.PersonalID)
-	}
-
-	if len(l.BusinessTaxID) > 0 {
-		values.Add("legal_entity[business_tax_id]", l.BusinessTaxID)
-	}
-
-	if len(l.BusinessVatID) > 0 {
-		values.Add("legal_entity[business_vat_id]", l.BusinessVatID)
-	}
-
-	if len(l.Address.Line1) > 0 {
-		values.Add("legal_entity[address][line1]", l.Address.Line1)
-	}
-
-	if len(l.Address.Line2) > 0 {
-		values.Add("legal_entity[address][line2]", l.Address.Line2)
-	}
-
-	if len(l.Address.City) > 0 {
-		values.Add("legal_entity[address][city]", l.Address.City)
-	}
-
-	if len(l.Address.State) > 0 {
-		values.Add("legal_entity[address][state]", l.Address.State)
-	}
-
-	if len(l.Address.Zip) > 0 {
-		values.Add("legal_entity[address][postal_code]", l.Address.Zip)
-	}
-
-	if len(l.Address.Country) > 0 {
-		values.Add("legal_entity[address][country]", l.Address.Country)
-	}
-
-	if len(l.PersonalAddress.Line1) > 0 {
-		values.Add("legal_entity[personal_address][line1]", l.PersonalAddress.Line1)
-	}
-
-	if len(l.PersonalAddress.Line2) > 0 {
-		values.Add("legal_entity[personal_address][line2]", l.PersonalAddress.Line2)
-	}
-
-	if len(l.PersonalAddress.City) > 0 {
-		values.Add("legal_entity[personal_address][city]", l.PersonalAddress.City)
-	}
-
-	if len(l.PersonalAddress.State) > 0 {
-		values.Add("legal_entity[personal_address][state]", l.PersonalAddress.State)
-	}
-
-	if len(l.PersonalAddress.Zip) > 0 {
-		values.Add("legal_entity[personal_address][postal_code]", l.PersonalAddress.Zip)
-	}
-
-	if len(l.PersonalAddress.Country) > 0 {
-		values.Add("legal_entity[personal_address][country]", l.PersonalAddress.Country)
-	}
-
-	for i, owner := range l.AdditionalOwners {
-		if len(owner.First) > 0 {
-			values.Add(fmt.Sprintf("legal_entity[additional_owners][%v][first_name]", i), owner.First)
-		}
-
-		if len(owner.Last) > 0 {
-			values.Add(fmt.Sprintf("legal_entity[additional_owners][%v][last_name]", i), owner.Last)
-		}
-
-		values.Add(fmt.Sprintf("legal_entity[additional_owners][%v][dob][day]", i), strconv.Itoa(owner.DOB.Day))
-		values.Add(fmt.Sprintf("legal_entity[additional_owners][%v][dob][month]", i), strconv.Itoa(owner.DOB.Month))
-		values.Add(fmt.Sprintf("legal_entity[additional_owners][%v][dob][year]", i), strconv.Itoa(owner.DOB.Year))
-
-		if len(owner.Address.Line1) > 0 {
-			values.Add(fmt.Sprintf("legal_entity[additional_owners][%v][address][line1]", i), owner.Address.Line1)
-		}
-
-		if len(owner.Address.Line2) > 0 {
-			values.Add(fmt.Sprintf("legal_entity[additional_owners][%v][address][line2]", i), owner.Address.Line2)
-		}
-
-		if len(owner.Address.City) > 0 {
-			values.Add(fmt.Sprintf("legal_entity[additional_owners][%v][address][city]", i), owner.Address.City)
-		}
-
-		if len(owner.Address.State) > 0 {
-			values.Add(fmt.Sprintf("legal_entity[additional_owners][%v][address][state]", i), owner.Address.State)
-		}
-
-		if len(owner.Address.Zip) > 0 {
-			values.Add(fmt.Sprintf("legal_entity[additional_owners][%v][address][postal_code]", i), owner.Address.Zip)
-		}
-
-		if len(owner.Address.Country) > 0 {
-			values.Add(fmt.Sprintf("legal_entity[additional_owners][%v][address][country]", i), owner.Address.Country)
-		}
-	}
-}
-
-func (t *TosAcceptanceParams) AppendDetails(values *url.Values) {
-	if !t.Date.IsZero() {
-		values.Add("tos_acceptance[date]", strconv.FormatInt(t.Date.Unix(), 10))
-	}
-	if t.Ip != nil {
-		values.Add("tos_acceptance[ip]", t.Ip.String())
-	}
-	if t.UserAgent != "" {
-		values.Add("tos_acceptance[user_agent]", t.UserAgent)
-	}
-}
+// AppendForm implements form.Appender for the transfer schedule, which
+// sends "minimum" as its delay and only the anchor matching its interval.
+func (t *TransferScheduleParams) AppendForm(values *url.Values, keyParts []string) {
+	key := form.FormatKey(keyParts)
 
-// AppendDetails adds the transfer schedule to the query string.
-func (t *TransferScheduleParams) AppendDetails(values *url.Values) {
 	if t.Delay > 0 {
-		values.Add("tranfer_schedule[delay_days]", strconv.FormatUint(t.Delay, 10))
+		values.Add(key+"[delay_days]", strconv.FormatUint(t.Delay, 10))
 	} else if t.MinimumDelay {
-		values.Add("transfer_schedule[delay_days]", "minimum")
+		values.Add(key+"[delay_days]", "minimum")
 	}
 
-	values.Add("transfer_schedule[interval]", string(t.Interval))
+	values.Add(key+"[interval]", string(t.Interval))
 	if t.Interval == Week && len(t.WeekAnchor) > 0 {
-		values.Add("transfer_schedule[weekly_anchor]", t.WeekAnchor)
+		values.Add(key+"[weekly_anchor]", t.WeekAnchor)
 	} else if t.Interval == Month && t.MonthAnchor > 0 {
-		values.Add("transfer_schedule[monthly_anchor]", strconv.FormatUint(t.MonthAnchor, 10))
+		values.Add(key+"[monthly_anchor]", strconv.FormatUint(t.MonthAnchor, 10))
 	}
 }
 
@@ -324,17 +268,7 @@ func (t *TransferScheduleParams) AppendDetails(values *url.Values) {
 // property may be an id or the full struct if it was expanded.
 func (a *Account) UnmarshalJSON(data []byte) error {
 	type account Account
-	var aa account
-	err := json.Unmarshal(data, &aa)
-
-	if err == nil {
-		*a = Account(aa)
-	} else {
-		// the id is surrounded by "\" characters, so strip them
-		a.ID = string(data[1 : len(data)-1])
-	}
-
-	return nil
+	return unmarshalExpandable(data, &a.ID, &a.Expanded, (*account)(a))
 }
 
 // UnmarshalJSON handles deserialization of an IdentityDocument.
@@ -342,15 +276,5 @@ func (a *Account) UnmarshalJSON(data []byte) error {
 // property may be an id or the full struct if it was expanded.
 func (d *IdentityDocument) UnmarshalJSON(data []byte) error {
 	type identityDocument IdentityDocument
-	var doc identityDocument
-	err := json.Unmarshal(data, &doc)
-
-	if err == nil {
-		*d = IdentityDocument(doc)
-	} else {
-		// the id is surrounded by "\" characters, so strip them
-		d.ID = string(data[1 : len(data)-1])
-	}
-
-	return nil
+	return unmarshalExpandable(data, &d.ID, &d.Expanded, (*identityDocument)(d))
 }
@@ -3,15 +3,18 @@ package account
 
 import (
 	"net/url"
-	"strconv"
 
 	stripe "github.com/channelmeter/stripe-go"
+	"github.com/channelmeter/stripe-go/form"
 )
 
 // Client is used to invoke /account APIs.
 type Client struct {
 	B   stripe.Backend
 	Key string
+	// NoValidate disables the client-side validation of params
+	// that is otherwise done before calling the backend.
+	NoValidate bool
 }
 
 // New creates a new account.
@@ -20,51 +23,12 @@ func New(params *stripe.AccountParams) (*stripe.Account, error) {
 }
 
 func (c Client) New(params *stripe.AccountParams) (*stripe.Account, error) {
-	body := &url.Values{
-		"managed": {strconv.FormatBool(params.Managed)},
+	if err := stripe.ValidateParams(params, c.NoValidate); err != nil {
+		return nil, err
 	}
 
-	if len(params.Country) > 0 {
-		body.Add("country", params.Country)
-	}
-
-	if len(params.Email) > 0 {
-		body.Add("email", params.Email)
-	}
-
-	if len(params.DefaultCurrency) > 0 {
-		body.Add("default_currency", params.DefaultCurrency)
-	}
-
-	if len(params.Statement) > 0 {
-		body.Add("statement_descriptor", params.Statement)
-	}
-
-	if len(params.BusinessName) > 0 {
-		body.Add("business_name", params.BusinessName)
-	}
-
-	if len(params.SupportPhone) > 0 {
-		body.Add("support_phone", params.SupportPhone)
-	}
-
-	if params.LegalEntity != nil {
-		params.LegalEntity.AppendDetails(body)
-	}
-
-	if params.TransferSchedule != nil {
-		params.TransferSchedule.AppendDetails(body)
-	}
-
-	if params.BankAccount != nil {
-		params.BankAccount.AppendDetails(body)
-	}
-
-	if params.TosAcceptance != nil {
-		params.TosAcceptance.AppendDetails(body)
-	}
-
-	params.AppendTo(body)
+	body := &url.Values{}
+	form.AppendTo(body, params)
 
 	acct := &stripe.Account{}
 	err := c.B.Call("POST", "/accounts", c.Key, body, &params.Params, acct)
@@ -111,46 +75,17 @@ func Update(id string, params *stripe.AccountParams) (*stripe.Account, error) {
 }
 
 func (c Client) Update(id string, params *stripe.AccountParams) (*stripe.Account, error) {
+	if err := stripe.ValidateParams(params, c.NoValidate); err != nil {
+		return nil, err
+	}
+
 	var body *url.Values
 	var commonParams *stripe.Params
 
 	if params != nil {
 		commonParams = &params.Params
 		body = &url.Values{}
-
-		if len(params.Email) > 0 {
-			body.Add("email", params.Email)
-		}
-
-		if len(params.DefaultCurrency) > 0 {
-			body.Add("default_currency", params.DefaultCurrency)
-		}
-
-		if len(params.Statement) > 0 {
-			body.Add("statement_descriptor", params.Statement)
-		}
-
-		if len(params.BusinessName) > 0 {
-			body.Add("business_name", params.BusinessName)
-		}
-
-		if len(params.SupportPhone) > 0 {
-			body.Add("support_phone", params.SupportPhone)
-		}
-
-		if params.LegalEntity != nil {
-			params.LegalEntity.AppendDetails(body)
-		}
-
-		if params.TransferSchedule != nil {
-			params.TransferSchedule.AppendDetails(body)
-		}
-
-		if params.BankAccount != nil {
-			params.BankAccount.AppendDetails(body)
-		}
-
-		params.AppendTo(body)
+		form.AppendTo(body, params)
 	}
 
 	acct := &stripe.Account{}
@@ -165,6 +100,10 @@ func List(params *stripe.AccountListParams) *Iter {
 }
 
 func (c Client) List(params *stripe.AccountListParams) *Iter {
+	if err := stripe.ValidateParams(params, c.NoValidate); err != nil {
+		return &Iter{stripe.GetIterErr(err)}
+	}
+
 	type accountList struct {
 		stripe.ListMeta
 		Values []*stripe.Account `json:"data"`
@@ -175,8 +114,7 @@ func (c Client) List(params *stripe.AccountListParams) *Iter {
 
 	if params != nil {
 		body = &url.Values{}
-
-		params.AppendTo(body)
+		form.AppendTo(body, params)
 		lp = &params.ListParams
 	}
 
@@ -207,5 +145,5 @@ func (i *Iter) Account() *stripe.Account {
 }
 
 func getC() Client {
-	return Client{stripe.GetBackend(stripe.APIBackend), stripe.Key}
+	return Client{B: stripe.GetBackend(stripe.APIBackend), Key: stripe.Key}
 }
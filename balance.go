@@ -1,14 +1,13 @@
 package stripe
 
-import "encoding/json"
-
 // TransactionStatus is the list of allowed values for the transaction's status.
 // Allowed values are "available", "pending".
 type TransactionStatus string
 
 // TransactionType is the list of allowed values for the transaction's type.
 // Allowed values are "charge", "refund", "adjustment", "application_fee",
-// "application_fee_refund", "transfer", "transfer_cancel", "transfer_failure".
+// "application_fee_refund", "transfer", "transfer_cancel", "transfer_failure",
+// "payout", "payout_cancel", "payout_failure", "topup", "topup_reversal".
 type TransactionType string
 
 // BalanceParams is the set of parameters that can be used when retrieving a balance.
@@ -27,28 +26,52 @@ type TxParams struct {
 // For more details see https://stripe.com/docs/api/#balance_history.
 type TxListParams struct {
 	ListParams
-	Created, Available      int64
-	Currency, Src, Transfer string
-	Type                    TransactionType
+	Created        Timestamp       `form:"created"`
+	CreatedRange   *RangeParams    `form:"created"`
+	Available      Timestamp       `form:"available_on"`
+	AvailableRange *RangeParams    `form:"available_on"`
+	Currency       string          `form:"currency"`
+	Src            string          `form:"source"`
+	Transfer       string          `form:"transfer"`
+	Type           TransactionType `form:"type"`
+	// Payout lists the transactions settled by an automatic payout.
+	Payout string `form:"payout"`
+}
+
+// Validate checks the balance transaction list parameters before they are sent.
+func (p *TxListParams) Validate() error {
+	v := &validation{}
+	p.ListParams.validate(v)
+	v.timeRange("created", p.Created, p.CreatedRange)
+	v.timeRange("available_on", p.Available, p.AvailableRange)
+	return v.err()
 }
 
 // Balance is the resource representing your Stripe balance.
 // For more details see https://stripe.com/docs/api/#balance.
 type Balance struct {
+	Extra
 	// Live indicates the live mode.
 	Live      bool     `json:"livemode"`
 	Available []Amount `json:"available"`
 	Pending   []Amount `json:"pending"`
 }
 
+// UnmarshalJSON handles deserialization of a Balance.
+func (b *Balance) UnmarshalJSON(data []byte) error {
+	type balance Balance
+	return unmarshalResource(data, (*balance)(b))
+}
+
 // Transaction is the resource representing the balance transaction.
 // For more details see https://stripe.com/docs/api/#balance.
 type Transaction struct {
+	Extra
 	ID         string            `json:"id"`
 	Amount     int64             `json:"amount"`
 	Currency   Currency          `json:"currency"`
-	Available  int64             `json:"available_on"`
-	Created    int64             `json:"created"`
+	Available  Timestamp         `json:"available_on"`
+	Created    Timestamp         `json:"created"`
 	Fee        int64             `json:"fee"`
 	FeeDetails []TxFee           `json:"fee_details"`
 	Net        int64             `json:"net"`
@@ -57,6 +80,7 @@ type Transaction struct {
 	Desc       string            `json:"description"`
 	Src        string            `json:"source"`
 	Recipient  string            `json:"recipient"`
+	Expanded   bool              `json:"-"`
 }
 
 type SourceTypes struct {
@@ -86,14 +110,5 @@ type TxFee struct {
 // property may be an id or the full struct if it was expanded.
 func (t *Transaction) UnmarshalJSON(data []byte) error {
 	type transaction Transaction
-	var tt transaction
-	err := json.Unmarshal(data, &tt)
-	if err == nil {
-		*t = Transaction(tt)
-	} else {
-		// the id is surrounded by "\" characters, so strip them
-		t.ID = string(data[1 : len(data)-1])
-	}
-
-	return nil
+	return unmarshalExpandable(data, &t.ID, &t.Expanded, (*transaction)(t))
 }
@@ -3,9 +3,9 @@ package balance
 
 import (
 	"net/url"
-	"strconv"
 
 	stripe "github.com/channelmeter/stripe-go"
+	"github.com/channelmeter/stripe-go/form"
 )
 
 const (
@@ -20,12 +20,20 @@ const (
 	TxTransfer       stripe.TransactionType = "transfer"
 	TxTransferCancel stripe.TransactionType = "transfer_cancel"
 	TxTransferFail   stripe.TransactionType = "transfer_failure"
+	TxPayout         stripe.TransactionType = "payout"
+	TxPayoutCancel   stripe.TransactionType = "payout_cancel"
+	TxPayoutFail     stripe.TransactionType = "payout_failure"
+	TxTopup          stripe.TransactionType = "topup"
+	TxTopupReversal  stripe.TransactionType = "topup_reversal"
 )
 
 // Client is used to invoke /balance and transaction-related APIs.
 type Client struct {
 	B   stripe.Backend
 	Key string
+	// NoValidate disables the client-side validation of params
+	// that is otherwise done before calling the backend.
+	NoValidate bool
 }
 
 // Get returns the details of your balance.
@@ -79,37 +87,16 @@ func List(params *stripe.TxListParams) *Iter {
 }
 
 func (c Client) List(params *stripe.TxListParams) *Iter {
+	if err := stripe.ValidateParams(params, c.NoValidate); err != nil {
+		return &Iter{stripe.GetIterErr(err)}
+	}
+
 	var body *url.Values
 	var lp *stripe.ListParams
 
 	if params != nil {
 		body = &url.Values{}
-
-		if params.Created > 0 {
-			body.Add("created", strconv.FormatInt(params.Created, 10))
-		}
-
-		if params.Available > 0 {
-			body.Add("available_on", strconv.FormatInt(params.Available, 10))
-		}
-
-		if len(params.Currency) > 0 {
-			body.Add("currency", params.Currency)
-		}
-
-		if len(params.Src) > 0 {
-			body.Add("source", params.Src)
-		}
-
-		if len(params.Transfer) > 0 {
-			body.Add("transfer", params.Transfer)
-		}
-
-		if len(params.Type) > 0 {
-			body.Add("type", string(params.Type))
-		}
-
-		params.AppendTo(body)
+		form.AppendTo(body, params)
 		lp = &params.ListParams
 	}
 
@@ -145,5 +132,5 @@ func (i *Iter) Transaction() *stripe.Transaction {
 }
 
 func getC() Client {
-	return Client{stripe.GetBackend(stripe.APIBackend), stripe.Key}
+	return Client{B: stripe.GetBackend(stripe.APIBackend), Key: stripe.Key}
 }
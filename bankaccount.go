@@ -1,8 +1,9 @@
 package stripe
 
 import (
-	"encoding/json"
 	"net/url"
+
+	"github.com/channelmeter/stripe-go/form"
 )
 
 // BankAccountStatus is the list of allowed values for the bank account's status.
@@ -11,9 +12,31 @@ type BankAccountStatus string
 
 // BankAccountParams is the set of parameters that can be used when creating or updating a bank account.
 type BankAccountParams struct {
-	Params
-	AccountID, Token, Country, Routing, Account, Currency string
-	Default                                               bool
+	Params    `form:"-"`
+	AccountID string `form:"-"`
+	Token     string `form:"-"`
+	Country   string `form:"country"`
+	Routing   string `form:"routing_number"`
+	Account   string `form:"account_number"`
+	Currency  string `form:"currency"`
+	Default   bool   `form:"default_for_currency"`
+}
+
+// Validate checks the bank account parameters before they are sent.
+func (b *BankAccountParams) Validate() error {
+	v := &validation{}
+	b.Params.validate(v)
+
+	if len(b.Token) > 0 {
+		if len(b.Account) > 0 || len(b.Routing) > 0 {
+			v.add("bank_account", "cannot set both a token and account details")
+		}
+	} else if len(b.Account) > 0 || len(b.Routing) > 0 || len(b.Country) > 0 {
+		v.required("bank_account[country]", b.Country)
+		v.required("bank_account[account_number]", b.Account)
+	}
+
+	return v.err()
 }
 
 // BankAccountListParams is the set of parameters that can be used when listing bank accounts.
@@ -22,8 +45,17 @@ type BankAccountListParams struct {
 	AccountID string
 }
 
+// Validate checks the bank account list parameters before they are sent.
+func (p *BankAccountListParams) Validate() error {
+	v := &validation{}
+	p.ListParams.validate(v)
+	v.required("account", p.AccountID)
+	return v.err()
+}
+
 // BankAccount represents a Stripe bank account.
 type BankAccount struct {
+	Extra
 	ID          string            `json:"id"`
 	Name        string            `json:"bank_name"`
 	Country     string            `json:"country"`
@@ -32,6 +64,7 @@ type BankAccount struct {
 	Fingerprint string            `json:"fingerprint"`
 	Status      BankAccountStatus `json:"status"`
 	Routing     string            `json:"routing_number"`
+	Expanded    bool              `json:"-"`
 }
 
 // BankAccountList is a list object for bank accounts.
@@ -40,19 +73,23 @@ type BankAccountList struct {
 	Values []*BankAccount `json:"data"`
 }
 
-// AppendDetails adds the bank account's details to the query string values.
-func (b *BankAccountParams) AppendDetails(values *url.Values) {
-	if len(b.Token) > 0 {
-		values.Add("bank_account", b.Token)
-	} else {
-		values.Add("bank_account[country]", b.Country)
-		values.Add("bank_account[routing_number]", b.Routing)
-		values.Add("bank_account[account_number]", b.Account)
+// AppendForm implements form.Appender for bank accounts. At the top level
+// the details are sent along with the common parameters, as when adding a
+// bank account to an account. Nested under a key, a token is sent as the
+// value of that key instead of the details.
+func (b *BankAccountParams) AppendForm(values *url.Values, keyParts []string) {
+	if len(keyParts) == 0 {
+		form.AppendFields(values, b, nil)
+		b.Params.AppendTo(values)
+		return
+	}
 
-		if len(b.Currency) > 0 {
-			values.Add("bank_account[currency]", b.Currency)
-		}
+	if len(b.Token) > 0 {
+		values.Add(form.FormatKey(keyParts), b.Token)
+		return
 	}
+
+	form.AppendFields(values, b, keyParts)
 }
 
 // UnmarshalJSON handles deserialization of a BankAccount.
@@ -60,14 +97,5 @@ func (b *BankAccountParams) AppendDetails(values *url.Values) {
 // property may be an id or the full struct if it was expanded.
 func (b *BankAccount) UnmarshalJSON(data []byte) error {
 	type bankAccount BankAccount
-	var bb bankAccount
-	err := json.Unmarshal(data, &bb)
-	if err == nil {
-		*b = BankAccount(bb)
-	} else {
-		// the id is surrounded by "\" characters, so strip them
-		b.ID = string(data[1 : len(data)-1])
-	}
-
-	return nil
+	return unmarshalExpandable(data, &b.ID, &b.Expanded, (*bankAccount)(b))
 }
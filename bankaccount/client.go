@@ -4,15 +4,18 @@ package bankaccount
 import (
 	"fmt"
 	"net/url"
-	"strconv"
 
 	stripe "github.com/channelmeter/stripe-go"
+	"github.com/channelmeter/stripe-go/form"
 )
 
 // Client is used to invoke /bank_accounts APIs.
 type Client struct {
 	B   stripe.Backend
 	Key string
+	// NoValidate disables the client-side validation of params
+	// that is otherwise done before calling the backend.
+	NoValidate bool
 }
 
 const (
@@ -28,17 +31,12 @@ func New(params *stripe.BankAccountParams) (*stripe.BankAccount, error) {
 }
 
 func (c Client) New(params *stripe.BankAccountParams) (*stripe.BankAccount, error) {
-	body := &url.Values{
-		"country":        {params.Country},
-		"account_number": {params.Account},
-		"routing_number": {params.Routing},
-		"currency":       {params.Currency},
+	if err := stripe.ValidateParams(params, c.NoValidate); err != nil {
+		return nil, err
 	}
 
-	if params.Default {
-		body.Add("default_for_currency", strconv.FormatBool(params.Default))
-	}
-	params.AppendTo(body)
+	body := &url.Values{}
+	form.AppendTo(body, params)
 
 	ba := &stripe.BankAccount{}
 	err := c.B.Call("POST", fmt.Sprintf("/accounts/%v/bank_accounts", params.AccountID), c.Key, body, &params.Params, ba)
@@ -73,18 +71,17 @@ func Update(id string, params *stripe.BankAccountParams) (*stripe.BankAccount, e
 }
 
 func (c Client) Update(id string, params *stripe.BankAccountParams) (*stripe.BankAccount, error) {
+	if err := stripe.ValidateParams(params, c.NoValidate); err != nil {
+		return nil, err
+	}
+
 	var body *url.Values
 	var commonParams *stripe.Params
 
 	if params != nil {
 		commonParams = &params.Params
 		body = &url.Values{}
-
-		if params.Default {
-			body.Add("default_for_currency", strconv.FormatBool(params.Default))
-		}
-
-		params.AppendTo(body)
+		form.AppendTo(body, params)
 	}
 
 	ba := &stripe.BankAccount{}
@@ -108,10 +105,14 @@ func List(params *stripe.BankAccountListParams) *Iter {
 }
 
 func (c Client) List(params *stripe.BankAccountListParams) *Iter {
+	if err := stripe.ValidateParams(params, c.NoValidate); err != nil {
+		return &Iter{stripe.GetIterErr(err)}
+	}
+
 	body := &url.Values{}
 	var lp *stripe.ListParams
 
-	params.AppendTo(body)
+	form.AppendTo(body, params)
 	lp = &params.ListParams
 
 	return &Iter{stripe.GetIter(lp, body, func(b url.Values) ([]interface{}, stripe.ListMeta, error) {
@@ -141,5 +142,5 @@ func (i *Iter) BankAccount() *stripe.BankAccount {
 }
 
 func getC() Client {
-	return Client{stripe.GetBackend(stripe.APIBackend), stripe.Key}
+	return Client{B: stripe.GetBackend(stripe.APIBackend), Key: stripe.Key}
 }
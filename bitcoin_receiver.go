@@ -1,38 +1,56 @@
 package stripe
 
-import (
-	"encoding/json"
-	"fmt"
-)
+import "fmt"
 
 // BitcoinReceiverListParams is the set of parameters that can be used when listing BitcoinReceivers.
 // For more details see https://stripe.com/docs/api/#list_bitcoin_receivers.
 type BitcoinReceiverListParams struct {
 	ListParams
-	NotFilled, NotActive, Uncaptured bool
+	NotFilled  bool `form:"filled,invert,zero"`
+	NotActive  bool `form:"active,invert,zero"`
+	Uncaptured bool `form:"uncaptured_funds,zero"`
 }
 
 // BitcoinReceiverParams is the set of parameters that can be used when creating a BitcoinReceiver.
 // For more details see https://stripe.com/docs/api/#create_bitcoin_receiver.
 type BitcoinReceiverParams struct {
 	Params
-	Amount      uint64
-	Currency    Currency
-	Desc, Email string
+	Amount   uint64   `form:"amount"`
+	Currency Currency `form:"currency"`
+	Desc     string   `form:"description"`
+	Email    string   `form:"email"`
+}
+
+// Validate checks the bitcoin receiver parameters before they are sent.
+func (p *BitcoinReceiverParams) Validate() error {
+	v := &validation{}
+	p.Params.validate(v)
+
+	if p.Amount == 0 {
+		v.add("amount", "is required")
+	}
+
+	v.required("currency", string(p.Currency))
+	v.required("email", p.Email)
+
+	return v.err()
 }
 
 // BitcoinReceiverParams is the set of parameters that can be used when updating a BitcoinReceiver.
 // For more details see https://stripe.com/docs/api/#update_bitcoin_receiver.
 type BitcoinReceiverUpdateParams struct {
 	Params
-	Desc, Email, RefundAddr string
+	Desc       string `form:"description"`
+	Email      string `form:"email"`
+	RefundAddr string `form:"refund_address"`
 }
 
 // BitcoinReceiver is the resource representing a Stripe bitcoin receiver.
 // For more details see https://stripe.com/docs/api/#bitcoin_receivers
 type BitcoinReceiver struct {
+	Extra
 	ID                    string                  `json:"id"`
-	Created               int64                   `json:"created"`
+	Created               Timestamp               `json:"created"`
 	Currency              Currency                `json:"currency"`
 	Amount                uint64                  `json:"amount"`
 	AmountReceived        uint64                  `json:"amount_received"`
@@ -50,6 +68,7 @@ type BitcoinReceiver struct {
 	Payment               string                  `json:"payment"`
 	Customer              string                  `json:"customer"`
 	Transactions          *BitcoinTransactionList `json:"transactions"`
+	Expanded              bool                    `json:"-"`
 }
 
 // Display human readable representation of a BitcoinReceiver.
@@ -70,14 +89,5 @@ func (br *BitcoinReceiver) Display() string {
 // property may be an id or the full struct if it was expanded.
 func (br *BitcoinReceiver) UnmarshalJSON(data []byte) error {
 	type bitcoinReceiver BitcoinReceiver
-	var r bitcoinReceiver
-	err := json.Unmarshal(data, &r)
-	if err == nil {
-		*br = BitcoinReceiver(r)
-	} else {
-		// the id is surrounded by "\" characters, so strip them
-		br.ID = string(data[1 : len(data)-1])
-	}
-
-	return nil
+	return unmarshalExpandable(data, &br.ID, &br.Expanded, (*bitcoinReceiver)(br))
 }
@@ -4,15 +4,18 @@ package bitcoinreceiver
 import (
 	"fmt"
 	"net/url"
-	"strconv"
 
 	stripe "github.com/channelmeter/stripe-go"
+	"github.com/channelmeter/stripe-go/form"
 )
 
 // Client is used to invoke /bitcoin/receivers APIs.
 type Client struct {
 	B   stripe.Backend
 	Key string
+	// NoValidate disables the client-side validation of params
+	// that is otherwise done before calling the backend.
+	NoValidate bool
 }
 
 // New POSTs new bitcoin receivers.
@@ -22,23 +25,15 @@ func New(params *stripe.BitcoinReceiverParams) (*stripe.BitcoinReceiver, error)
 }
 
 func (c Client) New(params *stripe.BitcoinReceiverParams) (*stripe.BitcoinReceiver, error) {
-	body := &url.Values{
-		"amount":   {strconv.FormatUint(params.Amount, 10)},
-		"currency": {string(params.Currency)},
+	if err := stripe.ValidateParams(params, c.NoValidate); err != nil {
+		return nil, err
 	}
 
-	if len(params.Desc) > 0 {
-		body.Add("description", params.Desc)
-	}
-
-	if len(params.Email) > 0 {
-		body.Add("email", params.Email)
-	}
+	body := &url.Values{}
+	form.AppendTo(body, params)
 
 	token := c.Key
 
-	params.AppendTo(body)
-
 	receiver := &stripe.BitcoinReceiver{}
 	err := c.B.Call("POST", "/bitcoin/receivers", token, body, &params.Params, receiver)
 
@@ -71,19 +66,12 @@ func Update(id string, params *stripe.BitcoinReceiverUpdateParams) (*stripe.Bitc
 }
 
 func (c Client) Update(id string, params *stripe.BitcoinReceiverUpdateParams) (*stripe.BitcoinReceiver, error) {
-	body := &url.Values{}
-
-	if len(params.Desc) > 0 {
-		body.Add("description", params.Desc)
+	if err := stripe.ValidateParams(params, c.NoValidate); err != nil {
+		return nil, err
 	}
 
-	if len(params.Email) > 0 {
-		body.Add("email", params.Email)
-	}
-
-	if len(params.RefundAddr) > 0 {
-		body.Add("refund_address", params.RefundAddr)
-	}
+	body := &url.Values{}
+	form.AppendTo(body, params)
 
 	receiver := &stripe.BitcoinReceiver{}
 	var err error
@@ -100,6 +88,10 @@ func List(params *stripe.BitcoinReceiverListParams) *Iter {
 }
 
 func (c Client) List(params *stripe.BitcoinReceiverListParams) *Iter {
+	if err := stripe.ValidateParams(params, c.NoValidate); err != nil {
+		return &Iter{stripe.GetIterErr(err)}
+	}
+
 	type receiverList struct {
 		stripe.ListMeta
 		Values []*stripe.BitcoinReceiver `json:"data"`
@@ -110,12 +102,7 @@ func (c Client) List(params *stripe.BitcoinReceiverListParams) *Iter {
 
 	if params != nil {
 		body = &url.Values{}
-
-		body.Add("filled", strconv.FormatBool(!params.NotFilled))
-		body.Add("active", strconv.FormatBool(!params.NotActive))
-		body.Add("uncaptured_funds", strconv.FormatBool(params.Uncaptured))
-
-		params.AppendTo(body)
+		form.AppendTo(body, params)
 		lp = &params.ListParams
 	}
 
@@ -146,5 +133,5 @@ func (i *Iter) BitcoinReceiver() *stripe.BitcoinReceiver {
 }
 
 func getC() Client {
-	return Client{stripe.GetBackend(stripe.APIBackend), stripe.Key}
+	return Client{B: stripe.GetBackend(stripe.APIBackend), Key: stripe.Key}
 }
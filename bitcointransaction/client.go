@@ -4,6 +4,7 @@ package bitcointransaction
 import (
 	"fmt"
 	stripe "github.com/channelmeter/stripe-go"
+	"github.com/channelmeter/stripe-go/form"
 	"net/url"
 )
 
@@ -11,6 +12,9 @@ import (
 type Client struct {
 	B   stripe.Backend
 	Key string
+	// NoValidate disables the client-side validation of params
+	// that is otherwise done before calling the backend.
+	NoValidate bool
 }
 
 // List returns a list of bitcoin transactions.
@@ -20,9 +24,8 @@ func List(params *stripe.BitcoinTransactionListParams) *Iter {
 }
 
 func (c Client) List(params *stripe.BitcoinTransactionListParams) *Iter {
-	type receiverList struct {
-		stripe.ListMeta
-		Values []*stripe.BitcoinTransaction `json:"data"`
+	if err := stripe.ValidateParams(params, c.NoValidate); err != nil {
+		return &Iter{stripe.GetIterErr(err)}
 	}
 
 	var body *url.Values
@@ -30,18 +33,38 @@ func (c Client) List(params *stripe.BitcoinTransactionListParams) *Iter {
 
 	if params != nil {
 		body = &url.Values{}
+		form.AppendTo(body, params)
+		lp = &params.ListParams
+	}
 
-		if len(params.Customer) > 0 {
-			body.Add("customer", params.Customer)
-		}
+	return &Iter{stripe.GetIter(lp, body, c.query(params.Receiver))}
+}
 
-		params.AppendTo(body)
-		lp = &params.ListParams
+// ListFrom returns all the transactions of a bitcoin receiver, starting with
+// the ones embedded in it and fetching the remaining pages as needed.
+func ListFrom(receiver *stripe.BitcoinReceiver) *Iter {
+	return getC().ListFrom(receiver)
+}
+
+func (c Client) ListFrom(receiver *stripe.BitcoinReceiver) *Iter {
+	list := receiver.Transactions
+	if list == nil {
+		list = &stripe.BitcoinTransactionList{ListMeta: stripe.ListMeta{More: true}}
+	}
+
+	values := make([]interface{}, len(list.Values))
+	for i, v := range list.Values {
+		values[i] = v
 	}
 
-	return &Iter{stripe.GetIter(lp, body, func(b url.Values) ([]interface{}, stripe.ListMeta, error) {
-		list := &receiverList{}
-		err := c.B.Call("GET", fmt.Sprintf("/bitcoin/receivers/%v/transactions", params.Receiver), c.Key, &b, nil, list)
+	return &Iter{stripe.GetIterFrom(nil, nil, values, list.ListMeta, c.query(receiver.ID))}
+}
+
+// query returns the query fetching a page of transactions of a receiver.
+func (c Client) query(receiverID string) stripe.Query {
+	return func(b url.Values) ([]interface{}, stripe.ListMeta, error) {
+		list := &stripe.BitcoinTransactionList{}
+		err := c.B.Call("GET", fmt.Sprintf("/bitcoin/receivers/%v/transactions", receiverID), c.Key, &b, nil, list)
 
 		ret := make([]interface{}, len(list.Values))
 		for i, v := range list.Values {
@@ -49,7 +72,7 @@ func (c Client) List(params *stripe.BitcoinTransactionListParams) *Iter {
 		}
 
 		return ret, list.ListMeta, err
-	})}
+	}
 }
 
 // Iter is an iterator for lists of BitcoinTransactions.
@@ -66,5 +89,5 @@ func (i *Iter) BitcoinTransaction() *stripe.BitcoinTransaction {
 }
 
 func getC() Client {
-	return Client{stripe.GetBackend(stripe.APIBackend), stripe.Key}
+	return Client{B: stripe.GetBackend(stripe.APIBackend), Key: stripe.Key}
 }
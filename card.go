@@ -1,10 +1,10 @@
 package stripe
 
 import (
-	"encoding/json"
-
 	"fmt"
 	"net/url"
+
+	"github.com/channelmeter/stripe-go/form"
 )
 
 // CardBrand is the list of allowed values for the card's brand.
@@ -23,11 +23,40 @@ type CardFunding string
 // CardParams is the set of parameters that can be used when creating or updating a card.
 // For more details see https://stripe.com/docs/api#create_card and https://stripe.com/docs/api#update_card.
 type CardParams struct {
-	Params
-	Token                                         string
-	Customer, Recipient                           string
-	Name, Number, Month, Year, CVC                string
-	Address1, Address2, City, State, Zip, Country string
+	Params    `form:"-"`
+	Token     string `form:"-"`
+	Customer  string `form:"-"`
+	Recipient string `form:"-"`
+	Name      string `form:"name"`
+	Number    string `form:"number"`
+	Month     string `form:"exp_month"`
+	Year      string `form:"exp_year"`
+	CVC       string `form:"cvc"`
+	Address1  string `form:"address_line1"`
+	Address2  string `form:"address_line2"`
+	City      string `form:"address_city"`
+	State     string `form:"address_state"`
+	Zip       string `form:"address_zip"`
+	Country   string `form:"address_country"`
+}
+
+// Validate checks that either a token or the raw card details are set.
+// For offline checks of the card number and expiry see the card package.
+func (c *CardParams) Validate() error {
+	v := &validation{}
+	c.Params.validate(v)
+
+	if len(c.Token) > 0 {
+		if len(c.Number) > 0 {
+			v.add("card", "cannot set both a token and a card number")
+		}
+	} else if len(c.Number) > 0 || len(c.Month) > 0 || len(c.Year) > 0 || len(c.CVC) > 0 {
+		v.required("card[number]", c.Number)
+		v.required("card[exp_month]", c.Month)
+		v.required("card[exp_year]", c.Year)
+	}
+
+	return v.err()
 }
 
 // CardListParams is the set of parameters that can be used when listing cards.
@@ -37,9 +66,22 @@ type CardListParams struct {
 	Customer, Recipient string
 }
 
+// Validate checks the card list parameters before they are sent.
+func (p *CardListParams) Validate() error {
+	v := &validation{}
+	p.ListParams.validate(v)
+
+	if len(p.Customer) == 0 && len(p.Recipient) == 0 {
+		v.add("customer", "either customer or recipient must be set")
+	}
+
+	return v.err()
+}
+
 // Card is the resource representing a Stripe credit/debit card.
 // For more details see https://stripe.com/docs/api#cards.
 type Card struct {
+	Extra
 	ID            string       `json:"id"`
 	Month         uint8        `json:"exp_month"`
 	Year          uint16       `json:"exp_year"`
@@ -60,6 +102,7 @@ type Card struct {
 	CVCCheck      Verification `json:"cvc_check"`
 	Name          string       `json:"name"`
 	Recipient     *Recipient   `json:"recipient"`
+	Expanded      bool         `json:"-"`
 }
 
 // CardList is a list object for cards.
@@ -68,80 +111,24 @@ type CardList struct {
 	Values []*Card `json:"data"`
 }
 
-// AppendDetails adds the card's details to the query string values.
-// When creating a new card, the parameters are passed as a dictionary, but
-// on updates they are simply the parameter name.
-func (c *CardParams) AppendDetails(values *url.Values, creating bool) {
-	if creating {
-		if len(c.Token) > 0 {
-			values.Add("card", c.Token)
-		} else {
-			values.Add("card[object]", "card")
-			values.Add("card[number]", c.Number)
-			values.Add("card[exp_month]", c.Month)
-			values.Add("card[exp_year]", c.Year)
-
-			if len(c.CVC) > 0 {
-				values.Add("card[cvc]", c.CVC)
-			}
-		}
-	}
-
-	if len(c.Name) > 0 {
-		if creating {
-			values.Add("card[name]", c.Name)
-		} else {
-			values.Add("name", c.Name)
-		}
-	}
-
-	if len(c.Address1) > 0 {
-		if creating {
-			values.Add("card[address_line1]", c.Address1)
-		} else {
-			values.Add("address_line1", c.Address1)
-		}
+// AppendForm implements form.Appender for cards. When creating a new card,
+// the parameters are passed as a dictionary nested under keyParts, or the
+// token is passed on its own, but on updates they are simply the parameter
+// name and are sent along with the common parameters.
+func (c *CardParams) AppendForm(values *url.Values, keyParts []string) {
+	if len(keyParts) == 0 {
+		form.AppendFields(values, c, nil)
+		c.Params.AppendTo(values)
+		return
 	}
 
-	if len(c.Address2) > 0 {
-		if creating {
-			values.Add("card[address_line2]", c.Address2)
-		} else {
-			values.Add("address_line2", c.Address2)
-		}
+	if len(c.Token) > 0 {
+		values.Add(form.FormatKey(keyParts), c.Token)
+		return
 	}
 
-	if len(c.City) > 0 {
-		if creating {
-			values.Add("card[address_city]", c.City)
-		} else {
-			values.Add("address_city", c.City)
-		}
-	}
-
-	if len(c.State) > 0 {
-		if creating {
-			values.Add("card[address_state]", c.State)
-		} else {
-			values.Add("address_state", c.State)
-		}
-	}
-
-	if len(c.Zip) > 0 {
-		if creating {
-			values.Add("card[address_zip]", c.Zip)
-		} else {
-			values.Add("address_zip", c.Zip)
-		}
-	}
-
-	if len(c.Country) > 0 {
-		if creating {
-			values.Add("card[address_country]", c.Country)
-		} else {
-			values.Add("address_country", c.Country)
-		}
-	}
+	values.Add(form.FormatKey(keyParts)+"[object]", "card")
+	form.AppendFields(values, c, keyParts)
 }
 
 // Display human readable representation of a Card.
@@ -154,14 +141,5 @@ func (c *Card) Display() string {
 // property may be an id or the full struct if it was expanded.
 func (c *Card) UnmarshalJSON(data []byte) error {
 	type card Card
-	var cc card
-	err := json.Unmarshal(data, &cc)
-	if err == nil {
-		*c = Card(cc)
-	} else {
-		// the id is surrounded by "\" characters, so strip them
-		c.ID = string(data[1 : len(data)-1])
-	}
-
-	return nil
+	return unmarshalExpandable(data, &c.ID, &c.Expanded, (*card)(c))
 }
@@ -7,6 +7,7 @@ import (
 	"net/url"
 
 	stripe "github.com/channelmeter/stripe-go"
+	"github.com/channelmeter/stripe-go/form"
 )
 
 const (
@@ -32,6 +33,9 @@ const (
 type Client struct {
 	B   stripe.Backend
 	Key string
+	// NoValidate disables the client-side validation of params
+	// that is otherwise done before calling the backend.
+	NoValidate bool
 }
 
 // New POSTs new cards either for a customer or recipient.
@@ -41,8 +45,12 @@ func New(params *stripe.CardParams) (*stripe.Card, error) {
 }
 
 func (c Client) New(params *stripe.CardParams) (*stripe.Card, error) {
+	if err := stripe.ValidateParams(params, c.NoValidate); err != nil {
+		return nil, err
+	}
+
 	body := &url.Values{}
-	params.AppendDetails(body, true)
+	form.AppendToPrefixed(body, params, []string{"card"})
 	params.AppendTo(body)
 
 	card := &stripe.Card{}
@@ -96,9 +104,12 @@ func Update(id string, params *stripe.CardParams) (*stripe.Card, error) {
 }
 
 func (c Client) Update(id string, params *stripe.CardParams) (*stripe.Card, error) {
+	if err := stripe.ValidateParams(params, c.NoValidate); err != nil {
+		return nil, err
+	}
+
 	body := &url.Values{}
-	params.AppendDetails(body, false)
-	params.AppendTo(body)
+	form.AppendTo(body, params)
 
 	card := &stripe.Card{}
 	var err error
@@ -138,10 +149,14 @@ func List(params *stripe.CardListParams) *Iter {
 }
 
 func (c Client) List(params *stripe.CardListParams) *Iter {
+	if err := stripe.ValidateParams(params, c.NoValidate); err != nil {
+		return &Iter{stripe.GetIterErr(err)}
+	}
+
 	body := &url.Values{}
 	var lp *stripe.ListParams
 
-	params.AppendTo(body)
+	form.AppendTo(body, params)
 	lp = &params.ListParams
 
 	return &Iter{stripe.GetIter(lp, body, func(b url.Values) ([]interface{}, stripe.ListMeta, error) {
@@ -179,5 +194,5 @@ func (i *Iter) Card() *stripe.Card {
 }
 
 func getC() Client {
-	return Client{stripe.GetBackend(stripe.APIBackend), stripe.Key}
+	return Client{B: stripe.GetBackend(stripe.APIBackend), Key: stripe.Key}
 }
@@ -9,11 +9,9 @@ import (
 	. "github.com/channelmeter/stripe-go/utils"
 )
 
-func init() {
+func TestCardNew(t *testing.T) {
 	stripe.Key = GetTestKey()
-}
 
-func TestCardNew(t *testing.T) {
 	customerParams := &stripe.CustomerParams{}
 	customerParams.SetSource(&stripe.CardParams{
 		Number: "378282246310005",
@@ -59,6 +57,8 @@ func TestCardNew(t *testing.T) {
 }
 
 func TestCardGet(t *testing.T) {
+	stripe.Key = GetTestKey()
+
 	recipientParams := &stripe.RecipientParams{
 		Name: "Test Recipient",
 		Type: recipient.Corp,
@@ -93,6 +93,8 @@ func TestCardGet(t *testing.T) {
 }
 
 func TestCardDel(t *testing.T) {
+	stripe.Key = GetTestKey()
+
 	customerParams := &stripe.CustomerParams{}
 	customerParams.SetSource(&stripe.CardParams{
 		Number: "378282246310005",
@@ -111,6 +113,8 @@ func TestCardDel(t *testing.T) {
 }
 
 func TestCardUpdate(t *testing.T) {
+	stripe.Key = GetTestKey()
+
 	customerParams := &stripe.CustomerParams{}
 	customerParams.SetSource(&stripe.CardParams{
 		Number: "378282246310005",
@@ -144,6 +148,8 @@ func TestCardUpdate(t *testing.T) {
 }
 
 func TestCardList(t *testing.T) {
+	stripe.Key = GetTestKey()
+
 	customerParams := &stripe.CustomerParams{}
 	customerParams.SetSource(&stripe.CardParams{
 		Number: "378282246310005",
@@ -0,0 +1,226 @@
+package card
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	stripe "github.com/channelmeter/stripe-go"
+)
+
+// now is the clock used for expiry checks; it is replaced in tests.
+var now = time.Now
+
+// maxExpiryYears is how far in the future an expiry year may be
+// before it is considered a typo.
+const maxExpiryYears = 50
+
+// brandRule describes the IIN prefixes, number lengths and CVC length of a brand.
+// Prefixes are given as inclusive ranges of equal-length numeric prefixes.
+type brandRule struct {
+	brand   stripe.CardBrand
+	ranges  [][2]string
+	lengths []int
+	cvc     int
+}
+
+var brandRules = []brandRule{
+	{Amex, [][2]string{{"34", "34"}, {"37", "37"}}, []int{15}, 4},
+	{DinersClub, [][2]string{{"300", "305"}, {"309", "309"}, {"36", "36"}, {"38", "39"}}, []int{14, 15, 16, 17, 18, 19}, 3},
+	{JCB, [][2]string{{"3528", "3589"}}, []int{16, 17, 18, 19}, 3},
+	{Discover, [][2]string{{"6011", "6011"}, {"622126", "622925"}, {"644", "649"}, {"65", "65"}}, []int{16, 17, 18, 19}, 3},
+	{MasterCard, [][2]string{{"51", "55"}, {"2221", "2720"}}, []int{16}, 3},
+	{Visa, [][2]string{{"4", "4"}}, []int{13, 16, 19}, 3},
+}
+
+// Brand detects the brand of a card number from its IIN range.
+// It returns BrandUnknown when no range matches.
+func Brand(number string) stripe.CardBrand {
+	if r := ruleFor(clean(number)); r != nil {
+		return r.brand
+	}
+
+	return BrandUnknown
+}
+
+// Luhn returns whether a card number passes the Luhn checksum.
+// Spaces and dashes in the number are ignored.
+func Luhn(number string) bool {
+	number = clean(number)
+	if len(number) == 0 || !isDigits(number) {
+		return false
+	}
+
+	sum := 0
+	double := false
+	for i := len(number) - 1; i >= 0; i-- {
+		d := int(number[i] - '0')
+		if double {
+			d *= 2
+			if d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+		double = !double
+	}
+
+	return sum%10 == 0
+}
+
+// ValidateNumber checks that a card number has a known brand, a length
+// valid for that brand and a correct Luhn checksum.
+func ValidateNumber(number string) error {
+	number = clean(number)
+	if !isDigits(number) || !Luhn(number) {
+		return cardError(stripe.InvalidNum, "number", "Your card number is incorrect.")
+	}
+
+	r := ruleFor(number)
+	if r == nil || !r.validLength(len(number)) {
+		return cardError(stripe.InvalidNum, "number", "Your card number is incorrect.")
+	}
+
+	return nil
+}
+
+// ValidateExpiry checks that an expiry month and a two or four digit
+// expiry year are well formed and not in the past.
+func ValidateExpiry(month, year string) error {
+	m, err := strconv.Atoi(strings.TrimSpace(month))
+	if err != nil || m < 1 || m > 12 {
+		return cardError(stripe.InvalidExpM, "exp_month", "Your card's expiration month is invalid.")
+	}
+
+	year = strings.TrimSpace(year)
+	y, err := strconv.Atoi(year)
+	if err != nil || y < 0 || (len(year) != 2 && len(year) != 4) {
+		return cardError(stripe.InvalidExpY, "exp_year", "Your card's expiration year is invalid.")
+	}
+
+	if len(year) == 2 {
+		y += 2000
+	}
+
+	t := now()
+	if y > t.Year()+maxExpiryYears {
+		return cardError(stripe.InvalidExpY, "exp_year", "Your card's expiration year is invalid.")
+	}
+
+	if y < t.Year() || (y == t.Year() && time.Month(m) < t.Month()) {
+		return cardError(stripe.ExpiredCard, "exp_month", "Your card has expired.")
+	}
+
+	return nil
+}
+
+// ValidateCVC checks that a security code has the length required by the brand.
+// Unknown brands accept both three and four digit codes.
+func ValidateCVC(cvc string, brand stripe.CardBrand) error {
+	cvc = strings.TrimSpace(cvc)
+
+	valid := isDigits(cvc) && (len(cvc) == 3 || len(cvc) == 4)
+	for _, r := range brandRules {
+		if r.brand == brand {
+			valid = isDigits(cvc) && len(cvc) == r.cvc
+			break
+		}
+	}
+
+	if !valid {
+		return cardError(stripe.InvalidCvc, "cvc", "Your card's security code is invalid.")
+	}
+
+	return nil
+}
+
+// Validate runs the offline checks on raw card details before they are sent,
+// returning a *stripe.Error with the same code and param the API would use.
+// Params referencing a token are not checked, and the CVC is only checked
+// when it is set since it is optional.
+func Validate(params *stripe.CardParams) error {
+	if params == nil || len(params.Token) > 0 {
+		return nil
+	}
+
+	if err := ValidateNumber(params.Number); err != nil {
+		return err
+	}
+
+	if err := ValidateExpiry(params.Month, params.Year); err != nil {
+		return err
+	}
+
+	if len(params.CVC) > 0 {
+		return ValidateCVC(params.CVC, Brand(params.Number))
+	}
+
+	return nil
+}
+
+func (r *brandRule) matches(number string) bool {
+	for _, rng := range r.ranges {
+		n := len(rng[0])
+		if len(number) < n {
+			continue
+		}
+
+		if p := number[:n]; p >= rng[0] && p <= rng[1] {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (r *brandRule) validLength(n int) bool {
+	for _, l := range r.lengths {
+		if l == n {
+			return true
+		}
+	}
+
+	return false
+}
+
+func ruleFor(number string) *brandRule {
+	for i := range brandRules {
+		if brandRules[i].matches(number) {
+			return &brandRules[i]
+		}
+	}
+
+	return nil
+}
+
+func cardError(code stripe.ErrorCode, param, msg string) error {
+	return &stripe.Error{
+		Type:  stripe.CardErr,
+		Msg:   msg,
+		Code:  code,
+		Param: param,
+	}
+}
+
+func clean(number string) string {
+	return strings.Map(func(r rune) rune {
+		if r == ' ' || r == '-' {
+			return -1
+		}
+		return r
+	}, number)
+}
+
+func isDigits(s string) bool {
+	if len(s) == 0 {
+		return false
+	}
+
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+
+	return true
+}
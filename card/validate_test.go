@@ -0,0 +1,100 @@
+package card
+
+import (
+	"testing"
+	"time"
+
+	stripe "github.com/channelmeter/stripe-go"
+)
+
+// The validation tests run offline, as the API key is only required by the
+// tests calling the API: go test ./card -run 'TestCard(Brand|Validate)'.
+
+func TestCardBrand(t *testing.T) {
+	cases := map[string]stripe.CardBrand{
+		"4242424242424242":    Visa,
+		"4000 0566 5566 5556": Visa,
+		"5555555555554444":    MasterCard,
+		"2223003122003222":    MasterCard,
+		"378282246310005":     Amex,
+		"6011111111111117":    Discover,
+		"30569309025904":      DinersClub,
+		"3530111333300000":    JCB,
+		"9999999999999995":    BrandUnknown,
+	}
+
+	for number, want := range cases {
+		if got := Brand(number); got != want {
+			t.Errorf("Brand(%q) = %v want %v", number, got, want)
+		}
+	}
+}
+
+func TestCardValidateNumber(t *testing.T) {
+	for _, number := range []string{"4242424242424242", "4242-4242-4242-4242", "378282246310005"} {
+		if err := ValidateNumber(number); err != nil {
+			t.Errorf("ValidateNumber(%q) = %v want nil", number, err)
+		}
+	}
+
+	for _, number := range []string{"4242424242424241", "424242424242", "", "4242abcd42424242", "9999999999999995"} {
+		err := ValidateNumber(number)
+		if stripeErr, ok := err.(*stripe.Error); !ok || stripeErr.Code != stripe.InvalidNum {
+			t.Errorf("ValidateNumber(%q) = %v want %v", number, err, stripe.InvalidNum)
+		}
+	}
+}
+
+func TestCardValidateExpiry(t *testing.T) {
+	now = func() time.Time { return time.Date(2015, time.June, 15, 0, 0, 0, 0, time.UTC) }
+	defer func() { now = time.Now }()
+
+	cases := []struct {
+		month, year string
+		want        stripe.ErrorCode
+	}{
+		{"06", "2015", ""},
+		{"6", "15", ""},
+		{"12", "2020", ""},
+		{"05", "2015", stripe.ExpiredCard},
+		{"12", "14", stripe.ExpiredCard},
+		{"13", "2020", stripe.InvalidExpM},
+		{"0", "2020", stripe.InvalidExpM},
+		{"10", "202", stripe.InvalidExpY},
+		{"10", "2099", stripe.InvalidExpY},
+	}
+
+	for _, c := range cases {
+		err := ValidateExpiry(c.month, c.year)
+		if c.want == "" {
+			if err != nil {
+				t.Errorf("ValidateExpiry(%q, %q) = %v want nil", c.month, c.year, err)
+			}
+			continue
+		}
+
+		if stripeErr, ok := err.(*stripe.Error); !ok || stripeErr.Code != c.want {
+			t.Errorf("ValidateExpiry(%q, %q) = %v want %v", c.month, c.year, err, c.want)
+		}
+	}
+}
+
+func TestCardValidate(t *testing.T) {
+	params := &stripe.CardParams{Number: "378282246310005", Month: "10", Year: "2099", CVC: "123"}
+	now = func() time.Time { return time.Date(2060, time.January, 1, 0, 0, 0, 0, time.UTC) }
+	defer func() { now = time.Now }()
+
+	err := Validate(params)
+	if stripeErr, ok := err.(*stripe.Error); !ok || stripeErr.Code != stripe.InvalidCvc || stripeErr.Param != "cvc" {
+		t.Errorf("Validate = %v want %v", err, stripe.InvalidCvc)
+	}
+
+	params.CVC = "1234"
+	if err := Validate(params); err != nil {
+		t.Errorf("Validate = %v want nil", err)
+	}
+
+	if err := Validate(&stripe.CardParams{Token: "tok_123"}); err != nil {
+		t.Errorf("Validate with token = %v want nil", err)
+	}
+}
@@ -1,7 +1,5 @@
 package stripe
 
-import "encoding/json"
-
 // Currency is the list of supported currencies.
 // For more details see https://support.stripe.com/questions/which-currencies-does-stripe-support.
 type Currency string
@@ -14,14 +12,49 @@ type FraudReport string
 // For more details see https://stripe.com/docs/api#create_charge and https://stripe.com/docs/api#update_charge.
 type ChargeParams struct {
 	Params
-	Amount                       uint64
-	Currency                     Currency
-	Customer, Token              string
-	Desc, Statement, Email, Dest string
-	NoCapture                    bool
-	Fee                          uint64
-	Fraud                        FraudReport
-	Source                       *SourceParams
+	Amount    uint64        `form:"amount"`
+	Currency  Currency      `form:"currency"`
+	Customer  string        `form:"customer"`
+	Token     string        `form:"-"`
+	Desc      string        `form:"description"`
+	Statement string        `form:"statement_descriptor"`
+	Email     string        `form:"receipt_email"`
+	Dest      string        `form:"destination"`
+	NoCapture bool          `form:"capture,invert"`
+	Fee       uint64        `form:"application_fee"`
+	Fraud     FraudReport   `form:"fraud_details[user_report]"`
+	Source    *SourceParams `form:"source"`
+}
+
+// Validate checks the charge parameters before they are sent.
+func (p *ChargeParams) Validate() error {
+	v := &validation{}
+	p.Params.validate(v)
+	v.statement("statement_descriptor", p.Statement, maxStatementLen)
+	v.oneOf("fraud_details[user_report]", string(p.Fraud), "fraudulent", "safe")
+
+	// amount and currency are only sent when creating a charge
+	if p.Amount > 0 || len(p.Currency) > 0 {
+		if p.Amount == 0 {
+			v.add("amount", "is required")
+		}
+
+		v.required("currency", string(p.Currency))
+
+		if p.Source == nil && len(p.Customer) == 0 {
+			v.add("source", "either customer or a source must be set")
+		}
+
+		if p.Fee > p.Amount {
+			v.add("application_fee", "must not be greater than amount")
+		}
+	}
+
+	if p.Source != nil {
+		v.nested(p.Source.Validate())
+	}
+
+	return v.err()
 }
 
 // SetSource adds valid sources to a ChargeParams object,
@@ -36,26 +69,49 @@ func (cp *ChargeParams) SetSource(sp interface{}) error {
 // For more details see https://stripe.com/docs/api#list_charges.
 type ChargeListParams struct {
 	ListParams
-	Created  int64
-	Customer string
+	Created      Timestamp    `form:"created"`
+	CreatedRange *RangeParams `form:"created"`
+	Customer     string       `form:"customer"`
+}
+
+// Validate checks the charge list parameters before they are sent.
+func (p *ChargeListParams) Validate() error {
+	v := &validation{}
+	p.ListParams.validate(v)
+	v.timeRange("created", p.Created, p.CreatedRange)
+	return v.err()
 }
 
 // CaptureParams is the set of parameters that can be used when capturing a charge.
 // For more details see https://stripe.com/docs/api#charge_capture.
 type CaptureParams struct {
 	Params
-	Amount, Fee uint64
-	Email       string
+	Amount uint64 `form:"amount"`
+	Fee    uint64 `form:"application_fee"`
+	Email  string `form:"receipt_email"`
+}
+
+// Validate checks the capture parameters before they are sent.
+func (p *CaptureParams) Validate() error {
+	v := &validation{}
+	p.Params.validate(v)
+
+	if p.Amount > 0 && p.Fee > p.Amount {
+		v.add("application_fee", "must not be greater than amount")
+	}
+
+	return v.err()
 }
 
 // Charge is the resource representing a Stripe charge.
 // For more details see https://stripe.com/docs/api#charges.
 type Charge struct {
+	Extra
 	ID             string            `json:"id"`
 	Live           bool              `json:"livemode"`
 	Amount         uint64            `json:"amount"`
 	Captured       bool              `json:"captured"`
-	Created        int64             `json:"created"`
+	Created        Timestamp         `json:"created"`
 	Currency       Currency          `json:"currency"`
 	Paid           bool              `json:"paid"`
 	Refunded       bool              `json:"refunded"`
@@ -74,6 +130,7 @@ type Charge struct {
 	FraudDetails   *FraudDetails     `json:"fraud_details"`
 	Status         string            `json:"status"`
 	Source         *PaymentSource    `json:"source"`
+	Expanded       bool              `json:"-"`
 }
 
 // FraudDetails is the structure detailing fraud status.
@@ -82,19 +139,16 @@ type FraudDetails struct {
 	StripeReport FraudReport `json:"stripe_report"`
 }
 
+// ChargeList is a list object for charges.
+type ChargeList struct {
+	ListMeta
+	Values []*Charge `json:"data"`
+}
+
 // UnmarshalJSON handles deserialization of a Charge.
 // This custom unmarshaling is needed because the resulting
 // property may be an id or the full struct if it was expanded.
 func (c *Charge) UnmarshalJSON(data []byte) error {
 	type charge Charge
-	var cc charge
-	err := json.Unmarshal(data, &cc)
-	if err == nil {
-		*c = Charge(cc)
-	} else {
-		// the id is surrounded by "\" characters, so strip them
-		c.ID = string(data[1 : len(data)-1])
-	}
-
-	return nil
+	return unmarshalExpandable(data, &c.ID, &c.Expanded, (*charge)(c))
 }
@@ -5,9 +5,9 @@ import (
 	"errors"
 	"fmt"
 	"net/url"
-	"strconv"
 
 	stripe "github.com/channelmeter/stripe-go"
+	"github.com/channelmeter/stripe-go/form"
 )
 
 const (
@@ -19,6 +19,9 @@ const (
 type Client struct {
 	B   stripe.Backend
 	Key string
+	// NoValidate disables the client-side validation of params
+	// that is otherwise done before calling the backend.
+	NoValidate bool
 }
 
 // New POSTs new charges.
@@ -28,9 +31,8 @@ func New(params *stripe.ChargeParams) (*stripe.Charge, error) {
 }
 
 func (c Client) New(params *stripe.ChargeParams) (*stripe.Charge, error) {
-	body := &url.Values{
-		"amount":   {strconv.FormatUint(params.Amount, 10)},
-		"currency": {string(params.Currency)},
+	if err := stripe.ValidateParams(params, c.NoValidate); err != nil {
+		return nil, err
 	}
 
 	if params.Source == nil && len(params.Customer) == 0 {
@@ -38,38 +40,10 @@ func (c Client) New(params *stripe.ChargeParams) (*stripe.Charge, error) {
 		return nil, err
 	}
 
-	if params.Source != nil {
-		params.Source.AppendDetails(body, true)
-	}
-
-	if len(params.Customer) > 0 {
-		body.Add("customer", params.Customer)
-	}
-
-	if len(params.Desc) > 0 {
-		body.Add("description", params.Desc)
-	}
-
-	if len(params.Statement) > 0 {
-		body.Add("statement_descriptor", params.Statement)
-	}
-
-	if len(params.Email) > 0 {
-		body.Add("receipt_email", params.Email)
-	}
-
-	if len(params.Dest) > 0 {
-		body.Add("destination", params.Dest)
-	}
-
-	body.Add("capture", strconv.FormatBool(!params.NoCapture))
+	body := &url.Values{}
+	form.AppendTo(body, params)
 
 	token := c.Key
-	if params.Fee > 0 {
-		body.Add("application_fee", strconv.FormatUint(params.Fee, 10))
-	}
-
-	params.AppendTo(body)
 
 	charge := &stripe.Charge{}
 	err := c.B.Call("POST", "/charges", token, body, &params.Params, charge)
@@ -106,22 +80,17 @@ func Update(id string, params *stripe.ChargeParams) (*stripe.Charge, error) {
 }
 
 func (c Client) Update(id string, params *stripe.ChargeParams) (*stripe.Charge, error) {
+	if err := stripe.ValidateParams(params, c.NoValidate); err != nil {
+		return nil, err
+	}
+
 	var body *url.Values
 	var commonParams *stripe.Params
 
 	if params != nil {
 		commonParams = &params.Params
 		body = &url.Values{}
-
-		if len(params.Desc) > 0 {
-			body.Add("description", params.Desc)
-		}
-
-		if len(params.Fraud) > 0 {
-			body.Add("fraud_details[user_report]", string(params.Fraud))
-		}
-
-		params.AppendTo(body)
+		form.AppendTo(body, params)
 	}
 
 	charge := &stripe.Charge{}
@@ -137,6 +106,10 @@ func Capture(id string, params *stripe.CaptureParams) (*stripe.Charge, error) {
 }
 
 func (c Client) Capture(id string, params *stripe.CaptureParams) (*stripe.Charge, error) {
+	if err := stripe.ValidateParams(params, c.NoValidate); err != nil {
+		return nil, err
+	}
+
 	var body *url.Values
 	token := c.Key
 	var commonParams *stripe.Params
@@ -144,20 +117,7 @@ func (c Client) Capture(id string, params *stripe.CaptureParams) (*stripe.Charge
 	if params != nil {
 		commonParams = &params.Params
 		body = &url.Values{}
-
-		if params.Amount > 0 {
-			body.Add("amount", strconv.FormatUint(params.Amount, 10))
-		}
-
-		if len(params.Email) > 0 {
-			body.Add("receipt_email", params.Email)
-		}
-
-		if params.Fee > 0 {
-			body.Add("application_fee", strconv.FormatUint(params.Fee, 10))
-		}
-
-		params.AppendTo(body)
+		form.AppendTo(body, params)
 	}
 
 	charge := &stripe.Charge{}
@@ -174,6 +134,10 @@ func List(params *stripe.ChargeListParams) *Iter {
 }
 
 func (c Client) List(params *stripe.ChargeListParams) *Iter {
+	if err := stripe.ValidateParams(params, c.NoValidate); err != nil {
+		return &Iter{stripe.GetIterErr(err)}
+	}
+
 	type chargeList struct {
 		stripe.ListMeta
 		Values []*stripe.Charge `json:"data"`
@@ -184,16 +148,7 @@ func (c Client) List(params *stripe.ChargeListParams) *Iter {
 
 	if params != nil {
 		body = &url.Values{}
-
-		if params.Created > 0 {
-			body.Add("created", strconv.FormatInt(params.Created, 10))
-		}
-
-		if len(params.Customer) > 0 {
-			body.Add("customer", params.Customer)
-		}
-
-		params.AppendTo(body)
+		form.AppendTo(body, params)
 		lp = &params.ListParams
 	}
 
@@ -246,5 +201,5 @@ func (i *Iter) Charge() *stripe.Charge {
 }
 
 func getC() Client {
-	return Client{stripe.GetBackend(stripe.APIBackend), stripe.Key}
+	return Client{B: stripe.GetBackend(stripe.APIBackend), Key: stripe.Key}
 }
@@ -0,0 +1,194 @@
+// Package session provides the /checkout/sessions APIs
+package session
+
+import (
+	"fmt"
+	"net/url"
+
+	stripe "github.com/channelmeter/stripe-go"
+	"github.com/channelmeter/stripe-go/form"
+)
+
+const (
+	Payment      stripe.CheckoutSessionMode = "payment"
+	Setup        stripe.CheckoutSessionMode = "setup"
+	Subscription stripe.CheckoutSessionMode = "subscription"
+
+	Paid              stripe.CheckoutSessionPaymentStatus = "paid"
+	Unpaid            stripe.CheckoutSessionPaymentStatus = "unpaid"
+	NoPaymentRequired stripe.CheckoutSessionPaymentStatus = "no_payment_required"
+
+	// EventCompleted is the type of the event sent when a customer
+	// completes a Checkout Session; see stripe.Event.CheckoutSession.
+	EventCompleted = "checkout.session.completed"
+)
+
+// Client is used to invoke /checkout/sessions APIs.
+type Client struct {
+	B   stripe.Backend
+	Key string
+	// NoValidate disables the client-side validation of params
+	// that is otherwise done before calling the backend.
+	NoValidate bool
+}
+
+// New POSTs a new Checkout Session.
+// For more details see https://stripe.com/docs/api/checkout/sessions/create.
+func New(params *stripe.CheckoutSessionParams) (*stripe.CheckoutSession, error) {
+	return getC().New(params)
+}
+
+func (c Client) New(params *stripe.CheckoutSessionParams) (*stripe.CheckoutSession, error) {
+	if err := stripe.ValidateParams(params, c.NoValidate); err != nil {
+		return nil, err
+	}
+
+	body := &url.Values{}
+	form.AppendTo(body, params)
+
+	session := &stripe.CheckoutSession{}
+	err := c.B.Call("POST", "/checkout/sessions", c.Key, body, stripe.CurrentVersion(&params.Params), session)
+
+	return session, err
+}
+
+// Get returns the details of a Checkout Session.
+// For more details see https://stripe.com/docs/api/checkout/sessions/retrieve.
+func Get(id string, params *stripe.CheckoutSessionParams) (*stripe.CheckoutSession, error) {
+	return getC().Get(id, params)
+}
+
+func (c Client) Get(id string, params *stripe.CheckoutSessionParams) (*stripe.CheckoutSession, error) {
+	var body *url.Values
+	var commonParams *stripe.Params
+
+	if params != nil {
+		commonParams = &params.Params
+		body = &url.Values{}
+		params.AppendTo(body)
+	}
+
+	session := &stripe.CheckoutSession{}
+	err := c.B.Call("GET", "/checkout/sessions/"+id, c.Key, body, stripe.CurrentVersion(commonParams), session)
+
+	return session, err
+}
+
+// List returns a list of Checkout Sessions.
+// For more details see https://stripe.com/docs/api/checkout/sessions/list.
+func List(params *stripe.CheckoutSessionListParams) *Iter {
+	return getC().List(params)
+}
+
+func (c Client) List(params *stripe.CheckoutSessionListParams) *Iter {
+	if err := stripe.ValidateParams(params, c.NoValidate); err != nil {
+		return &Iter{stripe.GetIterErr(err)}
+	}
+
+	var body *url.Values
+	var lp *stripe.ListParams
+
+	if params != nil {
+		body = &url.Values{}
+		form.AppendTo(body, params)
+		lp = &params.ListParams
+	}
+
+	return &Iter{stripe.GetIter(lp, body, func(b url.Values) ([]interface{}, stripe.ListMeta, error) {
+		list := &stripe.CheckoutSessionList{}
+		err := c.B.Call("GET", "/checkout/sessions", c.Key, &b, stripe.CurrentVersion(nil), list)
+
+		ret := make([]interface{}, len(list.Values))
+		for i, v := range list.Values {
+			ret[i] = v
+		}
+
+		return ret, list.ListMeta, err
+	})}
+}
+
+// ListLineItems returns a list of the line items of a Checkout Session.
+// For more details see https://stripe.com/docs/api/checkout/sessions/line_items.
+func ListLineItems(params *stripe.CheckoutSessionLineItemListParams) *LineItemIter {
+	return getC().ListLineItems(params)
+}
+
+func (c Client) ListLineItems(params *stripe.CheckoutSessionLineItemListParams) *LineItemIter {
+	if err := stripe.ValidateParams(params, c.NoValidate); err != nil {
+		return &LineItemIter{stripe.GetIterErr(err)}
+	}
+
+	body := &url.Values{}
+	var lp *stripe.ListParams
+
+	form.AppendTo(body, params)
+	lp = &params.ListParams
+
+	return &LineItemIter{stripe.GetIter(lp, body, c.query(params.ID))}
+}
+
+// ListLineItemsFrom returns all the line items of a Checkout Session, starting
+// with the ones embedded in it and fetching the remaining pages as needed.
+// The line items are only embedded when expanded.
+func ListLineItemsFrom(s *stripe.CheckoutSession) *LineItemIter {
+	return getC().ListLineItemsFrom(s)
+}
+
+func (c Client) ListLineItemsFrom(s *stripe.CheckoutSession) *LineItemIter {
+	list := s.LineItems
+	if list == nil {
+		list = &stripe.LineItemList{ListMeta: stripe.ListMeta{More: true}}
+	}
+
+	values := make([]interface{}, len(list.Values))
+	for i, v := range list.Values {
+		values[i] = v
+	}
+
+	return &LineItemIter{stripe.GetIterFrom(nil, nil, values, list.ListMeta, c.query(s.ID))}
+}
+
+// query returns the query fetching a page of line items of a Checkout Session.
+func (c Client) query(sessionID string) stripe.Query {
+	return func(b url.Values) ([]interface{}, stripe.ListMeta, error) {
+		list := &stripe.LineItemList{}
+		err := c.B.Call("GET", fmt.Sprintf("/checkout/sessions/%v/line_items", sessionID), c.Key, &b, stripe.CurrentVersion(nil), list)
+
+		ret := make([]interface{}, len(list.Values))
+		for i, v := range list.Values {
+			ret[i] = v
+		}
+
+		return ret, list.ListMeta, err
+	}
+}
+
+// Iter is an iterator for lists of CheckoutSessions.
+// The embedded Iter carries methods with it;
+// see its documentation for details.
+type Iter struct {
+	*stripe.Iter
+}
+
+// CheckoutSession returns the most recent CheckoutSession
+// visited by a call to Next.
+func (i *Iter) CheckoutSession() *stripe.CheckoutSession {
+	return i.Current().(*stripe.CheckoutSession)
+}
+
+// LineItemIter is an iterator for lists of LineItems.
+// The embedded Iter carries methods with it;
+// see its documentation for details.
+type LineItemIter struct {
+	*stripe.Iter
+}
+
+// LineItem returns the most recent LineItem
+// visited by a call to Next.
+func (i *LineItemIter) LineItem() *stripe.LineItem {
+	return i.Current().(*stripe.LineItem)
+}
+
+func getC() Client {
+	return Client{B: stripe.GetBackend(stripe.APIBackend), Key: stripe.Key}
+}
@@ -0,0 +1,87 @@
+package session
+
+import (
+	"testing"
+
+	stripe "github.com/channelmeter/stripe-go"
+	"github.com/channelmeter/stripe-go/currency"
+	"github.com/channelmeter/stripe-go/price"
+	. "github.com/channelmeter/stripe-go/utils"
+)
+
+func init() {
+	stripe.Key = GetTestKey()
+}
+
+func TestCheckoutSessionNew(t *testing.T) {
+	p, _ := price.New(&stripe.PriceParams{
+		Currency:    currency.USD,
+		UnitAmount:  2000,
+		ProductData: &stripe.PriceProductDataParams{Name: "Test Product"},
+	})
+
+	params := &stripe.CheckoutSessionParams{
+		Mode:               Payment,
+		PaymentMethodTypes: []string{"card"},
+		LineItems:          []*stripe.CheckoutSessionLineItemParams{{Price: p.ID, Quantity: 2}},
+		SuccessURL:         "https://example.com/success",
+		CancelURL:          "https://example.com/cancel",
+		ClientReferenceID:  "order_123",
+	}
+
+	target, err := New(params)
+
+	if err != nil {
+		t.Error(err)
+	}
+
+	if target.Mode != Payment {
+		t.Errorf("Mode %q does not match expected mode %q\n", target.Mode, Payment)
+	}
+
+	if target.ClientReferenceID != params.ClientReferenceID {
+		t.Errorf("Client reference %q does not match expected %q\n", target.ClientReferenceID, params.ClientReferenceID)
+	}
+
+	if target.PaymentStatus != Unpaid {
+		t.Errorf("Payment status %q does not match expected status %q\n", target.PaymentStatus, Unpaid)
+	}
+
+	target, err = Get(target.ID, nil)
+
+	if err != nil {
+		t.Error(err)
+	}
+
+	i := ListLineItems(&stripe.CheckoutSessionLineItemListParams{ID: target.ID})
+	for i.Next() {
+		item := i.LineItem()
+
+		if item.Price.ID != p.ID || item.Quantity != 2 {
+			t.Errorf("Line item %+v does not match the expected price and quantity\n", item)
+		}
+
+		if item.AmountTotal != 4000 {
+			t.Errorf("Amount %v does not match expected amount 4000\n", item.AmountTotal)
+		}
+	}
+	if err := i.Err(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestCheckoutSessionList(t *testing.T) {
+	params := &stripe.CheckoutSessionListParams{}
+	params.Filters.AddFilter("limit", "", "5")
+	params.Single = true
+
+	i := List(params)
+	for i.Next() {
+		if i.CheckoutSession() == nil {
+			t.Error("No nil values expected")
+		}
+	}
+	if err := i.Err(); err != nil {
+		t.Error(err)
+	}
+}
@@ -0,0 +1,184 @@
+package stripe
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// CheckoutSessionMode is the list of allowed values for what a Checkout Session is used for.
+// Allowed values are "payment", "setup", "subscription".
+type CheckoutSessionMode string
+
+// CheckoutSessionPaymentStatus is the list of allowed values for the payment status of a Checkout Session.
+// Allowed values are "paid", "unpaid", "no_payment_required".
+type CheckoutSessionPaymentStatus string
+
+// CheckoutSessionParams is the set of parameters that can be used when creating a Checkout Session.
+// For more details see https://stripe.com/docs/api/checkout/sessions/create.
+type CheckoutSessionParams struct {
+	Params
+	Mode               CheckoutSessionMode              `form:"mode"`
+	LineItems          []*CheckoutSessionLineItemParams `form:"line_items"`
+	PaymentMethodTypes []string                         `form:"payment_method_types"`
+	SuccessURL         string                           `form:"success_url"`
+	CancelURL          string                           `form:"cancel_url"`
+	ClientReferenceID  string                           `form:"client_reference_id"`
+	Customer           string                           `form:"customer"`
+	CustomerEmail      string                           `form:"customer_email"`
+}
+
+// CheckoutSessionLineItemParams is an item bought through a Checkout Session.
+type CheckoutSessionLineItemParams struct {
+	Price    string `form:"price"`
+	Quantity uint64 `form:"quantity"`
+}
+
+// Validate checks the Checkout Session parameters before they are sent.
+func (p *CheckoutSessionParams) Validate() error {
+	v := &validation{}
+	p.Params.validate(v)
+	v.oneOf("mode", string(p.Mode), "payment", "setup", "subscription")
+	v.required("success_url", p.SuccessURL)
+	v.required("cancel_url", p.CancelURL)
+
+	if len(p.Customer) > 0 && len(p.CustomerEmail) > 0 {
+		v.add("customer_email", "cannot be used together with customer")
+	}
+
+	if p.Mode == "setup" {
+		if len(p.LineItems) > 0 {
+			v.add("line_items", "cannot be used in setup mode")
+		}
+	} else if len(p.LineItems) == 0 {
+		v.add("line_items", "is required in %v mode", modeOrDefault(p.Mode))
+	}
+
+	for i, item := range p.LineItems {
+		v.required(fmt.Sprintf("line_items[%v][price]", i), item.Price)
+
+		if item.Quantity == 0 {
+			v.add(fmt.Sprintf("line_items[%v][quantity]", i), "is required")
+		}
+	}
+
+	return v.err()
+}
+
+// modeOrDefault returns the mode of a Checkout Session, which is payment
+// when it is not set.
+func modeOrDefault(mode CheckoutSessionMode) CheckoutSessionMode {
+	if len(mode) == 0 {
+		return "payment"
+	}
+
+	return mode
+}
+
+// CheckoutSessionListParams is the set of parameters that can be used when listing Checkout Sessions.
+// For more details see https://stripe.com/docs/api/checkout/sessions/list.
+type CheckoutSessionListParams struct {
+	ListParams
+	PaymentIntent string `form:"payment_intent"`
+	Sub           string `form:"subscription"`
+}
+
+// Validate checks the Checkout Session list parameters before they are sent.
+func (p *CheckoutSessionListParams) Validate() error {
+	v := &validation{}
+	p.ListParams.validate(v)
+	return v.err()
+}
+
+// CheckoutSessionLineItemListParams is the set of parameters that can be used when listing the line items of a Checkout Session.
+// For more details see https://stripe.com/docs/api/checkout/sessions/line_items.
+type CheckoutSessionLineItemListParams struct {
+	ListParams
+	ID string `form:"-"`
+}
+
+// Validate checks the Checkout Session line item list parameters before they are sent.
+func (p *CheckoutSessionLineItemListParams) Validate() error {
+	v := &validation{}
+	p.ListParams.validate(v)
+	v.required("id", p.ID)
+	return v.err()
+}
+
+// CheckoutSession is the resource representing a Stripe Checkout Session.
+// For more details see https://stripe.com/docs/api/checkout/sessions.
+type CheckoutSession struct {
+	Extra
+	ID                 string                       `json:"id"`
+	Live               bool                         `json:"livemode"`
+	AmountSubtotal     uint64                       `json:"amount_subtotal"`
+	AmountTotal        uint64                       `json:"amount_total"`
+	CancelURL          string                       `json:"cancel_url"`
+	ClientReferenceID  string                       `json:"client_reference_id"`
+	Currency           Currency                     `json:"currency"`
+	Customer           *Customer                    `json:"customer"`
+	CustomerEmail      string                       `json:"customer_email"`
+	LineItems          *LineItemList                `json:"line_items"`
+	Meta               map[string]string            `json:"metadata"`
+	Mode               CheckoutSessionMode          `json:"mode"`
+	PaymentIntent      *PaymentIntent               `json:"payment_intent"`
+	PaymentMethodTypes []string                     `json:"payment_method_types"`
+	PaymentStatus      CheckoutSessionPaymentStatus `json:"payment_status"`
+	SetupIntent        *SetupIntent                 `json:"setup_intent"`
+	Sub                *Sub                         `json:"subscription"`
+	SuccessURL         string                       `json:"success_url"`
+	URL                string                       `json:"url"`
+	Expanded           bool                         `json:"-"`
+}
+
+// LineItem is an item bought through a Checkout Session.
+type LineItem struct {
+	Extra
+	ID             string   `json:"id"`
+	AmountSubtotal uint64   `json:"amount_subtotal"`
+	AmountTotal    uint64   `json:"amount_total"`
+	Currency       Currency `json:"currency"`
+	Desc           string   `json:"description"`
+	Price          *Price   `json:"price"`
+	Quantity       uint64   `json:"quantity"`
+}
+
+// CheckoutSessionList is a list object for Checkout Sessions.
+type CheckoutSessionList struct {
+	ListMeta
+	Values []*CheckoutSession `json:"data"`
+}
+
+// LineItemList is a list object for line items.
+type LineItemList struct {
+	ListMeta
+	Values []*LineItem `json:"data"`
+}
+
+// UnmarshalJSON handles deserialization of a CheckoutSession.
+// This custom unmarshaling is needed because the resulting
+// property may be an id or the full struct if it was expanded.
+func (s *CheckoutSession) UnmarshalJSON(data []byte) error {
+	type checkoutSession CheckoutSession
+	return unmarshalExpandable(data, &s.ID, &s.Expanded, (*checkoutSession)(s))
+}
+
+// UnmarshalJSON handles deserialization of a LineItem.
+func (l *LineItem) UnmarshalJSON(data []byte) error {
+	type lineItem LineItem
+	return unmarshalResource(data, (*lineItem)(l))
+}
+
+// CheckoutSession returns the Checkout Session of an event about one,
+// such as checkout.session.completed.
+func (e *Event) CheckoutSession() (*CheckoutSession, error) {
+	if e.Data == nil || e.GetObjValue("object") != "checkout.session" {
+		return nil, fmt.Errorf("Event %v is not about a Checkout Session", e.ID)
+	}
+
+	s := &CheckoutSession{}
+	if err := json.Unmarshal(e.Data.Raw, s); err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
@@ -10,7 +10,9 @@ import (
 	"github.com/channelmeter/stripe-go/bitcointransaction"
 	"github.com/channelmeter/stripe-go/card"
 	"github.com/channelmeter/stripe-go/charge"
+	checkoutsession "github.com/channelmeter/stripe-go/checkout/session"
 	"github.com/channelmeter/stripe-go/coupon"
+	"github.com/channelmeter/stripe-go/creditnote"
 	"github.com/channelmeter/stripe-go/customer"
 	"github.com/channelmeter/stripe-go/discount"
 	"github.com/channelmeter/stripe-go/dispute"
@@ -20,13 +22,25 @@ import (
 	"github.com/channelmeter/stripe-go/fileupload"
 	"github.com/channelmeter/stripe-go/invoice"
 	"github.com/channelmeter/stripe-go/invoiceitem"
+	"github.com/channelmeter/stripe-go/paymentintent"
+	"github.com/channelmeter/stripe-go/paymentmethod"
+	"github.com/channelmeter/stripe-go/payout"
 	"github.com/channelmeter/stripe-go/plan"
+	"github.com/channelmeter/stripe-go/price"
+	"github.com/channelmeter/stripe-go/product"
 	"github.com/channelmeter/stripe-go/recipient"
 	"github.com/channelmeter/stripe-go/refund"
 	"github.com/channelmeter/stripe-go/reversal"
+	"github.com/channelmeter/stripe-go/setupintent"
 	"github.com/channelmeter/stripe-go/sub"
+	"github.com/channelmeter/stripe-go/subitem"
+	"github.com/channelmeter/stripe-go/taxid"
+	"github.com/channelmeter/stripe-go/taxrate"
 	"github.com/channelmeter/stripe-go/token"
+	"github.com/channelmeter/stripe-go/topup"
 	"github.com/channelmeter/stripe-go/transfer"
+	"github.com/channelmeter/stripe-go/usagerecord"
+	"github.com/channelmeter/stripe-go/usagerecordsummary"
 )
 
 // API is the Stripe client. It contains all the different resources available.
@@ -101,6 +115,48 @@ type API struct {
 	Reversals *reversal.Client
 	// BankAccounts is the client used to invoke /accounts/bank_accounts APIs.
 	BankAccounts *bankaccount.Client
+	// PaymentIntents is the client used to invoke /payment_intents APIs.
+	// For more details see https://stripe.com/docs/api#payment_intents.
+	PaymentIntents *paymentintent.Client
+	// PaymentMethods is the client used to invoke /payment_methods APIs.
+	// For more details see https://stripe.com/docs/api#payment_methods.
+	PaymentMethods *paymentmethod.Client
+	// SetupIntents is the client used to invoke /setup_intents APIs.
+	// For more details see https://stripe.com/docs/api#setup_intents.
+	SetupIntents *setupintent.Client
+	// Products is the client used to invoke /products APIs.
+	// For more details see https://stripe.com/docs/api#products.
+	Products *product.Client
+	// Prices is the client used to invoke /prices APIs.
+	// For more details see https://stripe.com/docs/api#prices.
+	Prices *price.Client
+	// SubItems is the client used to invoke /subscription_items APIs.
+	// For more details see https://stripe.com/docs/api#subscription_items.
+	SubItems *subitem.Client
+	// UsageRecords is the client used to invoke /subscription_items/usage_records APIs.
+	// For more details see https://stripe.com/docs/api#usage_records.
+	UsageRecords *usagerecord.Client
+	// UsageRecordSummaries is the client used to invoke /subscription_items/usage_record_summaries APIs.
+	// For more details see https://stripe.com/docs/api#usage_record_summaries.
+	UsageRecordSummaries *usagerecordsummary.Client
+	// CheckoutSessions is the client used to invoke /checkout/sessions APIs.
+	// For more details see https://stripe.com/docs/api/checkout/sessions.
+	CheckoutSessions *checkoutsession.Client
+	// Payouts is the client used to invoke /payouts APIs.
+	// For more details see https://stripe.com/docs/api#payouts.
+	Payouts *payout.Client
+	// Topups is the client used to invoke /topups APIs.
+	// For more details see https://stripe.com/docs/api#topups.
+	Topups *topup.Client
+	// CreditNotes is the client used to invoke /credit_notes APIs.
+	// For more details see https://stripe.com/docs/api/credit_notes.
+	CreditNotes *creditnote.Client
+	// TaxRates is the client used to invoke /tax_rates APIs.
+	// For more details see https://stripe.com/docs/api/tax_rates.
+	TaxRates *taxrate.Client
+	// TaxIDs is the client used to invoke /customers/tax_ids APIs.
+	// For more details see https://stripe.com/docs/api/customer_tax_ids.
+	TaxIDs *taxid.Client
 }
 
 // Init initializes the Stripe client with the appropriate secret key
@@ -134,4 +190,18 @@ func (a *API) Init(key string, backends *Backends) {
 	a.BitcoinTransactions = &bitcointransaction.Client{B: backends.API, Key: key}
 	a.Reversals = &reversal.Client{B: backends.API, Key: key}
 	a.BankAccounts = &bankaccount.Client{B: backends.API, Key: key}
+	a.PaymentIntents = &paymentintent.Client{B: backends.API, Key: key}
+	a.PaymentMethods = &paymentmethod.Client{B: backends.API, Key: key}
+	a.SetupIntents = &setupintent.Client{B: backends.API, Key: key}
+	a.Products = &product.Client{B: backends.API, Key: key}
+	a.Prices = &price.Client{B: backends.API, Key: key}
+	a.SubItems = &subitem.Client{B: backends.API, Key: key}
+	a.UsageRecords = &usagerecord.Client{B: backends.API, Key: key}
+	a.UsageRecordSummaries = &usagerecordsummary.Client{B: backends.API, Key: key}
+	a.CheckoutSessions = &checkoutsession.Client{B: backends.API, Key: key}
+	a.Payouts = &payout.Client{B: backends.API, Key: key}
+	a.Topups = &topup.Client{B: backends.API, Key: key}
+	a.CreditNotes = &creditnote.Client{B: backends.API, Key: key}
+	a.TaxRates = &taxrate.Client{B: backends.API, Key: key}
+	a.TaxIDs = &taxid.Client{B: backends.API, Key: key}
 }
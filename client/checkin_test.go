@@ -7,6 +7,7 @@ import (
 	stripe "github.com/channelmeter/stripe-go"
 	"github.com/channelmeter/stripe-go/currency"
 	"github.com/channelmeter/stripe-go/plan"
+	"github.com/channelmeter/stripe-go/testmode"
 )
 
 const testKey = "tGN0bIwXnHdwOa85VABjPdSn8nWY7G7I"
@@ -19,14 +20,10 @@ func TestCheckinIdempotency(t *testing.T) {
 		Amount:   100,
 		Currency: currency.USD,
 		Source: &stripe.SourceParams{
-			Card: &stripe.CardParams{
-				Name:   "Go Bindings Cardholder",
-				Number: "4242424242424242",
-				Month:  "12",
-				Year:   "24",
-			},
+			Card: testmode.Visa.Params(),
 		},
 	}
+	charge.Source.Card.Name = "Go Bindings Cardholder"
 
 	charge.Params.IdempotencyKey = stripe.NewIdempotencyKey()
 
@@ -96,14 +93,10 @@ func TestCheckinPost(t *testing.T) {
 		Amount:   100,
 		Currency: currency.USD,
 		Source: &stripe.SourceParams{
-			Card: &stripe.CardParams{
-				Name:   "Go Bindings Cardholder",
-				Number: "4242424242424242",
-				Month:  "12",
-				Year:   "24",
-			},
+			Card: testmode.Visa.Params(),
 		},
 	}
+	charge.Source.Card.Name = "Go Bindings Cardholder"
 
 	target, err := c.Charges.New(charge)
 
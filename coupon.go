@@ -1,7 +1,5 @@
 package stripe
 
-import "encoding/json"
-
 // CouponDuration is the list of allowed values for the coupon's duration.
 // Allowed values are "forever", "once", "repeating".
 type CouponDuration string
@@ -10,25 +8,76 @@ type CouponDuration string
 // For more details see https://stripe.com/docs/api#create_coupon.
 type CouponParams struct {
 	Params
-	Duration                                     CouponDuration
-	ID                                           string
-	Currency                                     Currency
-	Amount, Percent, DurationPeriod, Redemptions uint64
-	RedeemBy                                     int64
+	Duration       CouponDuration `form:"duration"`
+	ID             string         `form:"id"`
+	Currency       Currency       `form:"currency"`
+	Amount         uint64         `form:"amount_off"`
+	Percent        uint64         `form:"percent_off"`
+	DurationPeriod uint64         `form:"duration_in_months"`
+	Redemptions    uint64         `form:"max_redemptions"`
+	RedeemBy       Timestamp      `form:"redeem_by"`
+}
+
+// Validate checks the coupon parameters before they are sent.
+func (p *CouponParams) Validate() error {
+	v := &validation{}
+	p.Params.validate(v)
+
+	if p.Amount > 0 && p.Percent > 0 {
+		v.add("percent_off", "cannot be used together with amount_off")
+	}
+
+	if p.Amount > 0 && len(p.Currency) == 0 {
+		v.add("currency", "is required with amount_off")
+	}
+
+	if p.Percent > 100 {
+		v.add("percent_off", "must be between 1 and 100")
+	}
+
+	// duration is only sent when creating a coupon
+	if len(p.Duration) > 0 {
+		v.oneOf("duration", string(p.Duration), "forever", "once", "repeating")
+
+		if p.Amount == 0 && p.Percent == 0 {
+			v.add("amount_off", "either amount_off or percent_off is required")
+		}
+
+		if p.Duration == "repeating" && p.DurationPeriod == 0 {
+			v.add("duration_in_months", "is required when duration is repeating")
+		}
+	}
+
+	if p.DurationPeriod > 0 && p.Duration != "repeating" {
+		v.add("duration_in_months", "can only be used when duration is repeating")
+	}
+
+	return v.err()
 }
 
 // CouponListParams is the set of parameters that can be used when listing coupons.
 // For more detail see https://stripe.com/docs/api#list_coupons.
 type CouponListParams struct {
 	ListParams
+	Created      Timestamp    `form:"created"`
+	CreatedRange *RangeParams `form:"created"`
+}
+
+// Validate checks the coupon list parameters before they are sent.
+func (p *CouponListParams) Validate() error {
+	v := &validation{}
+	p.ListParams.validate(v)
+	v.timeRange("created", p.Created, p.CreatedRange)
+	return v.err()
 }
 
 // Coupon is the resource representing a Stripe coupon.
 // For more details see https://stripe.com/docs/api#coupons.
 type Coupon struct {
+	Extra
 	ID             string            `json:"id"`
 	Live           bool              `json:"livemode"`
-	Created        int64             `json:"created"`
+	Created        Timestamp         `json:"created"`
 	Duration       CouponDuration    `json:"duration"`
 	Amount         uint64            `json:"amount_off"`
 	Currency       Currency          `json:"currency"`
@@ -36,9 +85,10 @@ type Coupon struct {
 	Redemptions    uint64            `json:"max_redemptions"`
 	Meta           map[string]string `json:"metadata"`
 	Percent        uint64            `json:"percent_off"`
-	RedeemBy       int64             `json:"redeem_by"`
+	RedeemBy       Timestamp         `json:"redeem_by"`
 	Redeemed       uint64            `json:"times_redeemed"`
 	Valid          bool              `json:"valid"`
+	Expanded       bool              `json:"-"`
 }
 
 // UnmarshalJSON handles deserialization of a Coupon.
@@ -46,14 +96,5 @@ type Coupon struct {
 // property may be an id or the full struct if it was expanded.
 func (c *Coupon) UnmarshalJSON(data []byte) error {
 	type coupon Coupon
-	var cc coupon
-	err := json.Unmarshal(data, &cc)
-	if err == nil {
-		*c = Coupon(cc)
-	} else {
-		// the id is surrounded by "\" characters, so strip them
-		c.ID = string(data[1 : len(data)-1])
-	}
-
-	return nil
+	return unmarshalExpandable(data, &c.ID, &c.Expanded, (*coupon)(c))
 }
@@ -4,9 +4,9 @@ package coupon
 import (
 	"errors"
 	"net/url"
-	"strconv"
 
 	stripe "github.com/channelmeter/stripe-go"
+	"github.com/channelmeter/stripe-go/form"
 )
 
 const (
@@ -19,6 +19,9 @@ const (
 type Client struct {
 	B   stripe.Backend
 	Key string
+	// NoValidate disables the client-side validation of params
+	// that is otherwise done before calling the backend.
+	NoValidate bool
 }
 
 // New POSTs new coupons.
@@ -28,39 +31,19 @@ func New(params *stripe.CouponParams) (*stripe.Coupon, error) {
 }
 
 func (c Client) New(params *stripe.CouponParams) (*stripe.Coupon, error) {
-	// TODO: this doesn't check that the params are not nil.
-
-	body := &url.Values{
-		"duration": {string(params.Duration)},
+	if err := stripe.ValidateParams(params, c.NoValidate); err != nil {
+		return nil, err
 	}
 
-	if len(params.ID) > 0 {
-		body.Add("id", params.ID)
-	}
+	// TODO: this doesn't check that the params are not nil.
 
-	if params.Percent > 0 {
-		body.Add("percent_off", strconv.FormatUint(params.Percent, 10))
-	} else if params.Amount > 0 {
-		body.Add("amount_off", strconv.FormatUint(params.Amount, 10))
-		body.Add("currency", string(params.Currency))
-	} else {
+	if params.Percent == 0 && params.Amount == 0 {
 		err := errors.New("Invalid coupon params: either amount and currency or percent need to be set")
 		return nil, err
 	}
 
-	if params.Duration == Repeating {
-		body.Add("duration_in_months", strconv.FormatUint(params.DurationPeriod, 10))
-	}
-
-	if params.Redemptions > 0 {
-		body.Add("max_redemptions", strconv.FormatUint(params.Redemptions, 10))
-	}
-
-	if params.RedeemBy > 0 {
-		body.Add("redeem_by", strconv.FormatInt(params.RedeemBy, 10))
-	}
-
-	params.AppendTo(body)
+	body := &url.Values{}
+	form.AppendTo(body, params)
 
 	coupon := &stripe.Coupon{}
 
@@ -109,6 +92,10 @@ func List(params *stripe.CouponListParams) *Iter {
 }
 
 func (c Client) List(params *stripe.CouponListParams) *Iter {
+	if err := stripe.ValidateParams(params, c.NoValidate); err != nil {
+		return &Iter{stripe.GetIterErr(err)}
+	}
+
 	type couponList struct {
 		stripe.ListMeta
 		Values []*stripe.Coupon `json:"data"`
@@ -119,8 +106,7 @@ func (c Client) List(params *stripe.CouponListParams) *Iter {
 
 	if params != nil {
 		body = &url.Values{}
-
-		params.AppendTo(body)
+		form.AppendTo(body, params)
 		lp = &params.ListParams
 	}
 
@@ -151,5 +137,5 @@ func (i *Iter) Coupon() *stripe.Coupon {
 }
 
 func getC() Client {
-	return Client{stripe.GetBackend(stripe.APIBackend), stripe.Key}
+	return Client{B: stripe.GetBackend(stripe.APIBackend), Key: stripe.Key}
 }
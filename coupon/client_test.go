@@ -21,7 +21,7 @@ func TestCouponNew(t *testing.T) {
 		Duration:       Repeating,
 		DurationPeriod: 3,
 		Redemptions:    1,
-		RedeemBy:       time.Now().AddDate(0, 0, 30).Unix(),
+		RedeemBy:       stripe.NewTimestamp(time.Now().AddDate(0, 0, 30)),
 	}
 
 	target, err := New(couponParams)
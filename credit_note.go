@@ -0,0 +1,207 @@
+package stripe
+
+import "fmt"
+
+// CreditNoteReason is the list of allowed values for the credit note's reason.
+// Allowed values are "duplicate", "fraudulent", "order_change", "product_unsatisfactory".
+type CreditNoteReason string
+
+// CreditNoteStatus is the list of allowed values for the credit note's status.
+// Allowed values are "issued", "void".
+type CreditNoteStatus string
+
+// CreditNoteType is the list of allowed values for the credit note's type,
+// which depends on whether the invoice was paid when it was issued.
+// Allowed values are "pre_payment", "post_payment".
+type CreditNoteType string
+
+// CreditNoteLineType is the list of allowed values for the credit note line item's type.
+// Allowed values are "invoice_line_item", "custom_line_item".
+type CreditNoteLineType string
+
+// CreditNoteParams is the set of parameters that can be used when previewing or issuing a credit note.
+// The credited amount is split between the customer's credit balance (CreditAmount),
+// a refund (RefundAmount, or Refund for an existing one) and OutOfBandAmount.
+// For more details see https://stripe.com/docs/api/credit_notes/create and https://stripe.com/docs/api/credit_notes/preview.
+type CreditNoteParams struct {
+	Params
+	Invoice         string                  `form:"invoice"`
+	Amount          uint64                  `form:"amount"`
+	CreditAmount    uint64                  `form:"credit_amount"`
+	Lines           []*CreditNoteLineParams `form:"lines"`
+	Memo            string                  `form:"memo"`
+	OutOfBandAmount uint64                  `form:"out_of_band_amount"`
+	Reason          CreditNoteReason        `form:"reason"`
+	Refund          string                  `form:"refund"`
+	RefundAmount    uint64                  `form:"refund_amount"`
+}
+
+// CreditNoteLineParams is a line item of a credit note. A line item either
+// credits part of an invoice line item, referenced by InvoiceLine, or is a
+// custom amount described by Desc.
+type CreditNoteLineParams struct {
+	Type        CreditNoteLineType `form:"type"`
+	InvoiceLine string             `form:"invoice_line_item"`
+	Amount      uint64             `form:"amount"`
+	Desc        string             `form:"description"`
+	Quantity    uint64             `form:"quantity"`
+	UnitAmount  uint64             `form:"unit_amount"`
+}
+
+// Validate checks the credit note parameters before they are sent.
+func (p *CreditNoteParams) Validate() error {
+	v := &validation{}
+	p.Params.validate(v)
+	v.required("invoice", p.Invoice)
+	v.oneOf("reason", string(p.Reason), "duplicate", "fraudulent", "order_change", "product_unsatisfactory")
+
+	if p.Amount == 0 && len(p.Lines) == 0 {
+		v.add("amount", "is required when no lines are credited")
+	}
+
+	if len(p.Refund) > 0 && p.RefundAmount > 0 {
+		v.add("refund_amount", "cannot be used together with refund")
+	}
+
+	for i, line := range p.Lines {
+		param := fmt.Sprintf("lines[%v]", i)
+		v.required(param+"[type]", string(line.Type))
+		v.oneOf(param+"[type]", string(line.Type), "invoice_line_item", "custom_line_item")
+
+		switch line.Type {
+		case "invoice_line_item":
+			v.required(param+"[invoice_line_item]", line.InvoiceLine)
+
+			if line.Amount > 0 && line.Quantity > 0 {
+				v.add(param+"[quantity]", "cannot be used together with amount")
+			}
+		case "custom_line_item":
+			v.required(param+"[description]", line.Desc)
+
+			if len(line.InvoiceLine) > 0 {
+				v.add(param+"[invoice_line_item]", "can only be used with an invoice_line_item line")
+			}
+
+			if line.UnitAmount == 0 {
+				v.add(param+"[unit_amount]", "is required")
+			}
+		}
+	}
+
+	return v.err()
+}
+
+// CreditNoteUpdateParams is the set of parameters that can be used when updating a credit note.
+// Only the memo and metadata can be updated.
+// For more details see https://stripe.com/docs/api/credit_notes/update.
+type CreditNoteUpdateParams struct {
+	Params
+	Memo string `form:"memo"`
+}
+
+// Validate checks the credit note update parameters before they are sent.
+func (p *CreditNoteUpdateParams) Validate() error {
+	v := &validation{}
+	p.Params.validate(v)
+	return v.err()
+}
+
+// CreditNoteListParams is the set of parameters that can be used when listing credit notes.
+// For more details see https://stripe.com/docs/api/credit_notes/list.
+type CreditNoteListParams struct {
+	ListParams
+	Customer string `form:"customer"`
+	Invoice  string `form:"invoice"`
+}
+
+// Validate checks the credit note list parameters before they are sent.
+func (p *CreditNoteListParams) Validate() error {
+	v := &validation{}
+	p.ListParams.validate(v)
+	return v.err()
+}
+
+// CreditNoteLineListParams is the set of parameters that can be used when listing credit note line items.
+// For more details see https://stripe.com/docs/api/credit_notes/lines.
+type CreditNoteLineListParams struct {
+	ListParams
+	ID string `form:"-"`
+}
+
+// Validate checks the credit note line list parameters before they are sent.
+func (p *CreditNoteLineListParams) Validate() error {
+	v := &validation{}
+	p.ListParams.validate(v)
+	v.required("id", p.ID)
+	return v.err()
+}
+
+// CreditNote is the resource representing a Stripe credit note.
+// For more details see https://stripe.com/docs/api/credit_notes/object.
+type CreditNote struct {
+	Extra
+	ID                string              `json:"id"`
+	Live              bool                `json:"livemode"`
+	Amount            int64               `json:"amount"`
+	Created           Timestamp           `json:"created"`
+	Currency          Currency            `json:"currency"`
+	Customer          *Customer           `json:"customer"`
+	CustomerBalanceTx string              `json:"customer_balance_transaction"`
+	DiscountAmount    int64               `json:"discount_amount"`
+	Invoice           *Invoice            `json:"invoice"`
+	Lines             *CreditNoteLineList `json:"lines"`
+	Memo              string              `json:"memo"`
+	Meta              map[string]string   `json:"metadata"`
+	Number            string              `json:"number"`
+	OutOfBandAmount   int64               `json:"out_of_band_amount"`
+	PDF               string              `json:"pdf"`
+	Reason            CreditNoteReason    `json:"reason"`
+	Refund            *Refund             `json:"refund"`
+	Status            CreditNoteStatus    `json:"status"`
+	Subtotal          int64               `json:"subtotal"`
+	Total             int64               `json:"total"`
+	Type              CreditNoteType      `json:"type"`
+	Voided            Timestamp           `json:"voided_at"`
+	Expanded          bool                `json:"-"`
+}
+
+// CreditNoteLine is the resource representing a Stripe credit note line item.
+// For more details see https://stripe.com/docs/api/credit_notes/line_item.
+type CreditNoteLine struct {
+	Extra
+	ID             string             `json:"id"`
+	Live           bool               `json:"livemode"`
+	Amount         int64              `json:"amount"`
+	Desc           string             `json:"description"`
+	DiscountAmount int64              `json:"discount_amount"`
+	InvoiceLine    string             `json:"invoice_line_item"`
+	Quantity       uint64             `json:"quantity"`
+	Type           CreditNoteLineType `json:"type"`
+	UnitAmount     int64              `json:"unit_amount"`
+}
+
+// CreditNoteList is a list object for credit notes.
+type CreditNoteList struct {
+	ListMeta
+	Values []*CreditNote `json:"data"`
+}
+
+// CreditNoteLineList is a list object for credit note line items.
+type CreditNoteLineList struct {
+	ListMeta
+	Values []*CreditNoteLine `json:"data"`
+}
+
+// UnmarshalJSON handles deserialization of a CreditNote.
+// This custom unmarshaling is needed because the resulting
+// property may be an id or the full struct if it was expanded.
+func (c *CreditNote) UnmarshalJSON(data []byte) error {
+	type creditNote CreditNote
+	return unmarshalExpandable(data, &c.ID, &c.Expanded, (*creditNote)(c))
+}
+
+// UnmarshalJSON handles deserialization of a CreditNoteLine.
+func (l *CreditNoteLine) UnmarshalJSON(data []byte) error {
+	type creditNoteLine CreditNoteLine
+	return unmarshalResource(data, (*creditNoteLine)(l))
+}
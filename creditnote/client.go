@@ -0,0 +1,267 @@
+// Package creditnote provides the /credit_notes APIs
+package creditnote
+
+import (
+	"fmt"
+	"net/url"
+
+	stripe "github.com/channelmeter/stripe-go"
+	"github.com/channelmeter/stripe-go/form"
+)
+
+const (
+	Duplicate             stripe.CreditNoteReason = "duplicate"
+	Fraudulent            stripe.CreditNoteReason = "fraudulent"
+	OrderChange           stripe.CreditNoteReason = "order_change"
+	ProductUnsatisfactory stripe.CreditNoteReason = "product_unsatisfactory"
+
+	IssuedCreditNote stripe.CreditNoteStatus = "issued"
+	VoidCreditNote   stripe.CreditNoteStatus = "void"
+
+	PrePayment  stripe.CreditNoteType = "pre_payment"
+	PostPayment stripe.CreditNoteType = "post_payment"
+
+	TypeInvoiceLine stripe.CreditNoteLineType = "invoice_line_item"
+	TypeCustomLine  stripe.CreditNoteLineType = "custom_line_item"
+)
+
+// Client is used to invoke /credit_notes APIs.
+type Client struct {
+	B   stripe.Backend
+	Key string
+	// NoValidate disables the client-side validation of params
+	// that is otherwise done before calling the backend.
+	NoValidate bool
+}
+
+// New POSTs a new credit note, issuing it for an invoice.
+// For more details see https://stripe.com/docs/api/credit_notes/create.
+func New(params *stripe.CreditNoteParams) (*stripe.CreditNote, error) {
+	return getC().New(params)
+}
+
+func (c Client) New(params *stripe.CreditNoteParams) (*stripe.CreditNote, error) {
+	if err := stripe.ValidateParams(params, c.NoValidate); err != nil {
+		return nil, err
+	}
+
+	body := &url.Values{}
+	form.AppendTo(body, params)
+
+	note := &stripe.CreditNote{}
+	err := c.B.Call("POST", "/credit_notes", c.Key, body, stripe.CurrentVersion(&params.Params), note)
+
+	return note, err
+}
+
+// Preview returns the credit note that New would issue for the same params,
+// without issuing it.
+// For more details see https://stripe.com/docs/api/credit_notes/preview.
+func Preview(params *stripe.CreditNoteParams) (*stripe.CreditNote, error) {
+	return getC().Preview(params)
+}
+
+func (c Client) Preview(params *stripe.CreditNoteParams) (*stripe.CreditNote, error) {
+	if err := stripe.ValidateParams(params, c.NoValidate); err != nil {
+		return nil, err
+	}
+
+	body := &url.Values{}
+	form.AppendTo(body, params)
+
+	note := &stripe.CreditNote{}
+	err := c.B.Call("GET", "/credit_notes/preview", c.Key, body, stripe.CurrentVersion(&params.Params), note)
+
+	return note, err
+}
+
+// Get returns the details of a credit note.
+// For more details see https://stripe.com/docs/api/credit_notes/retrieve.
+func Get(id string, params *stripe.CreditNoteParams) (*stripe.CreditNote, error) {
+	return getC().Get(id, params)
+}
+
+func (c Client) Get(id string, params *stripe.CreditNoteParams) (*stripe.CreditNote, error) {
+	var body *url.Values
+	var commonParams *stripe.Params
+
+	if params != nil {
+		commonParams = &params.Params
+		body = &url.Values{}
+		params.AppendTo(body)
+	}
+
+	note := &stripe.CreditNote{}
+	err := c.B.Call("GET", "/credit_notes/"+id, c.Key, body, stripe.CurrentVersion(commonParams), note)
+
+	return note, err
+}
+
+// Update updates a credit note's memo and metadata.
+// For more details see https://stripe.com/docs/api/credit_notes/update.
+func Update(id string, params *stripe.CreditNoteUpdateParams) (*stripe.CreditNote, error) {
+	return getC().Update(id, params)
+}
+
+func (c Client) Update(id string, params *stripe.CreditNoteUpdateParams) (*stripe.CreditNote, error) {
+	if err := stripe.ValidateParams(params, c.NoValidate); err != nil {
+		return nil, err
+	}
+
+	var body *url.Values
+	var commonParams *stripe.Params
+
+	if params != nil {
+		commonParams = &params.Params
+
+		body = &url.Values{}
+		form.AppendTo(body, params)
+	}
+
+	note := &stripe.CreditNote{}
+	err := c.B.Call("POST", "/credit_notes/"+id, c.Key, body, stripe.CurrentVersion(commonParams), note)
+
+	return note, err
+}
+
+// Void voids an issued credit note, reversing its effect on the
+// customer's credit balance. Refunds already made are not reversed.
+// For more details see https://stripe.com/docs/api/credit_notes/void.
+func Void(id string, params *stripe.CreditNoteParams) (*stripe.CreditNote, error) {
+	return getC().Void(id, params)
+}
+
+func (c Client) Void(id string, params *stripe.CreditNoteParams) (*stripe.CreditNote, error) {
+	var body *url.Values
+	var commonParams *stripe.Params
+
+	if params != nil {
+		commonParams = &params.Params
+
+		body = &url.Values{}
+		params.AppendTo(body)
+	}
+
+	note := &stripe.CreditNote{}
+	err := c.B.Call("POST", fmt.Sprintf("/credit_notes/%v/void", id), c.Key, body, stripe.CurrentVersion(commonParams), note)
+
+	return note, err
+}
+
+// List returns a list of credit notes.
+// For more details see https://stripe.com/docs/api/credit_notes/list.
+func List(params *stripe.CreditNoteListParams) *Iter {
+	return getC().List(params)
+}
+
+func (c Client) List(params *stripe.CreditNoteListParams) *Iter {
+	if err := stripe.ValidateParams(params, c.NoValidate); err != nil {
+		return &Iter{stripe.GetIterErr(err)}
+	}
+
+	var body *url.Values
+	var lp *stripe.ListParams
+
+	if params != nil {
+		body = &url.Values{}
+		form.AppendTo(body, params)
+		lp = &params.ListParams
+	}
+
+	return &Iter{stripe.GetIter(lp, body, func(b url.Values) ([]interface{}, stripe.ListMeta, error) {
+		list := &stripe.CreditNoteList{}
+		err := c.B.Call("GET", "/credit_notes", c.Key, &b, stripe.CurrentVersion(nil), list)
+
+		ret := make([]interface{}, len(list.Values))
+		for i, v := range list.Values {
+			ret[i] = v
+		}
+
+		return ret, list.ListMeta, err
+	})}
+}
+
+// ListLines returns a list of the line items of a credit note.
+// For more details see https://stripe.com/docs/api/credit_notes/lines.
+func ListLines(params *stripe.CreditNoteLineListParams) *LineIter {
+	return getC().ListLines(params)
+}
+
+func (c Client) ListLines(params *stripe.CreditNoteLineListParams) *LineIter {
+	if err := stripe.ValidateParams(params, c.NoValidate); err != nil {
+		return &LineIter{stripe.GetIterErr(err)}
+	}
+
+	body := &url.Values{}
+	var lp *stripe.ListParams
+
+	form.AppendTo(body, params)
+	lp = &params.ListParams
+
+	return &LineIter{stripe.GetIter(lp, body, c.query(params.ID))}
+}
+
+// ListLinesFrom returns all the line items of a credit note, starting with the
+// ones embedded in it and fetching the remaining pages as needed.
+func ListLinesFrom(note *stripe.CreditNote) *LineIter {
+	return getC().ListLinesFrom(note)
+}
+
+func (c Client) ListLinesFrom(note *stripe.CreditNote) *LineIter {
+	list := note.Lines
+	if list == nil {
+		list = &stripe.CreditNoteLineList{ListMeta: stripe.ListMeta{More: true}}
+	}
+
+	values := make([]interface{}, len(list.Values))
+	for i, v := range list.Values {
+		values[i] = v
+	}
+
+	return &LineIter{stripe.GetIterFrom(nil, nil, values, list.ListMeta, c.query(note.ID))}
+}
+
+// query returns the query fetching a page of line items of a credit note.
+func (c Client) query(noteID string) stripe.Query {
+	return func(b url.Values) ([]interface{}, stripe.ListMeta, error) {
+		list := &stripe.CreditNoteLineList{}
+		err := c.B.Call("GET", fmt.Sprintf("/credit_notes/%v/lines", noteID), c.Key, &b, stripe.CurrentVersion(nil), list)
+
+		ret := make([]interface{}, len(list.Values))
+		for i, v := range list.Values {
+			ret[i] = v
+		}
+
+		return ret, list.ListMeta, err
+	}
+}
+
+// Iter is an iterator for lists of CreditNotes.
+// The embedded Iter carries methods with it;
+// see its documentation for details.
+type Iter struct {
+	*stripe.Iter
+}
+
+// CreditNote returns the most recent CreditNote
+// visited by a call to Next.
+func (i *Iter) CreditNote() *stripe.CreditNote {
+	return i.Current().(*stripe.CreditNote)
+}
+
+// LineIter is an iterator for lists of CreditNoteLines.
+// The embedded Iter carries methods with it;
+// see its documentation for details.
+type LineIter struct {
+	*stripe.Iter
+}
+
+// CreditNoteLine returns the most recent CreditNoteLine
+// visited by a call to Next.
+func (i *LineIter) CreditNoteLine() *stripe.CreditNoteLine {
+	return i.Current().(*stripe.CreditNoteLine)
+}
+
+func getC() Client {
+	return Client{B: stripe.GetBackend(stripe.APIBackend), Key: stripe.Key}
+}
@@ -0,0 +1,133 @@
+package creditnote
+
+import (
+	"testing"
+
+	stripe "github.com/channelmeter/stripe-go"
+	"github.com/channelmeter/stripe-go/currency"
+	"github.com/channelmeter/stripe-go/customer"
+	"github.com/channelmeter/stripe-go/invoice"
+	"github.com/channelmeter/stripe-go/invoiceitem"
+	. "github.com/channelmeter/stripe-go/utils"
+)
+
+func init() {
+	stripe.Key = GetTestKey()
+}
+
+// Credit notes can only be issued for finalized invoices, so test
+// everything together starting from a paid invoice
+func TestAllCreditNoteScenarios(t *testing.T) {
+	customerParams := &stripe.CustomerParams{
+		Source: &stripe.SourceParams{
+			Card: &stripe.CardParams{
+				Number: "4242424242424242",
+				Month:  "06",
+				Year:   "30",
+			},
+		},
+	}
+
+	cust, _ := customer.New(customerParams)
+
+	_, err := invoiceitem.New(&stripe.InvoiceItemParams{
+		Customer: cust.ID,
+		Amount:   2000,
+		Currency: currency.USD,
+		Desc:     "Test Item",
+	})
+
+	if err != nil {
+		t.Error(err)
+	}
+
+	inv, err := invoice.New(&stripe.InvoiceParams{Customer: cust.ID})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err = invoice.Pay(inv.ID, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	params := &stripe.CreditNoteParams{
+		Invoice: inv.ID,
+		Lines: []*stripe.CreditNoteLineParams{
+			{Type: TypeInvoiceLine, InvoiceLine: inv.Lines.Values[0].ID, Amount: 500},
+		},
+		RefundAmount: 500,
+		Reason:       OrderChange,
+		Memo:         "Partial refund",
+	}
+
+	preview, err := Preview(params)
+
+	if err != nil {
+		t.Error(err)
+	}
+
+	if preview.Total != 500 {
+		t.Errorf("Preview total %v does not match expected total 500\n", preview.Total)
+	}
+
+	target, err := New(params)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if target.Invoice.ID != inv.ID {
+		t.Errorf("Invoice %q does not match expected invoice %q\n", target.Invoice.ID, inv.ID)
+	}
+
+	if target.Status != IssuedCreditNote || target.Type != PostPayment {
+		t.Errorf("Unexpected status %q and type %q\n", target.Status, target.Type)
+	}
+
+	if target.Refund == nil {
+		t.Errorf("Refund is not set\n")
+	}
+
+	lines := ListLines(&stripe.CreditNoteLineListParams{ID: target.ID})
+	for lines.Next() {
+		if lines.CreditNoteLine().InvoiceLine != inv.Lines.Values[0].ID {
+			t.Errorf("Line invoice line %q does not match expected line %q\n", lines.CreditNoteLine().InvoiceLine, inv.Lines.Values[0].ID)
+		}
+	}
+	if err := lines.Err(); err != nil {
+		t.Error(err)
+	}
+
+	updated, err := Update(target.ID, &stripe.CreditNoteUpdateParams{Memo: "Updated memo"})
+
+	if err != nil {
+		t.Error(err)
+	}
+
+	if updated.Memo != "Updated memo" {
+		t.Errorf("Memo %q does not match expected memo\n", updated.Memo)
+	}
+
+	i := List(&stripe.CreditNoteListParams{Invoice: inv.ID})
+	for i.Next() {
+		if i.CreditNote() == nil {
+			t.Error("No nil values expected")
+		}
+	}
+	if err := i.Err(); err != nil {
+		t.Error(err)
+	}
+
+	voided, err := Void(target.ID, nil)
+
+	if err != nil {
+		t.Error(err)
+	}
+
+	if voided.Status != VoidCreditNote {
+		t.Errorf("Status %q does not match expected status %q\n", voided.Status, VoidCreditNote)
+	}
+
+	customer.Del(cust.ID)
+}
@@ -1,4 +1,5 @@
-// Package currency provides the list of currency codes
+// Package currency provides the list of currency codes and helpers
+// for working with amounts expressed in their minor units
 package currency
 
 import (
@@ -0,0 +1,242 @@
+package currency
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"unicode"
+
+	stripe "github.com/channelmeter/stripe-go"
+)
+
+// nbsp is the non-breaking space used between amounts and symbols
+// so that rendered amounts are never split across lines.
+const nbsp = "\u00a0"
+
+// Locale describes how amounts are rendered for a given language and region.
+// Grouping lists the sizes of the digit groups from the right; the last
+// size repeats, so {3} gives 1,234,567 and {3, 2} gives 12,34,567.
+type Locale struct {
+	Tag         string
+	Decimal     string
+	Group       string
+	Grouping    []int
+	SymbolFirst bool
+	SymbolSpace bool
+}
+
+// EnUS is the locale used when a requested locale is not known.
+var EnUS = Locale{"en-US", ".", ",", []int{3}, true, false}
+
+var locales = map[string]Locale{
+	"en-US": EnUS,
+	"en-AU": {"en-AU", ".", ",", []int{3}, true, false},
+	"en-CA": {"en-CA", ".", ",", []int{3}, true, false},
+	"en-GB": {"en-GB", ".", ",", []int{3}, true, false},
+	"en-IE": {"en-IE", ".", ",", []int{3}, true, false},
+	"en-IN": {"en-IN", ".", ",", []int{3, 2}, true, false},
+	"en-NZ": {"en-NZ", ".", ",", []int{3}, true, false},
+	"en-SG": {"en-SG", ".", ",", []int{3}, true, false},
+	"da-DK": {"da-DK", ",", ".", []int{3}, false, true},
+	"de-AT": {"de-AT", ",", nbsp, []int{3}, true, true},
+	"de-CH": {"de-CH", ".", "’", []int{3}, true, true},
+	"de-DE": {"de-DE", ",", ".", []int{3}, false, true},
+	"es-ES": {"es-ES", ",", ".", []int{3}, false, true},
+	"es-MX": {"es-MX", ".", ",", []int{3}, true, false},
+	"fi-FI": {"fi-FI", ",", nbsp, []int{3}, false, true},
+	"fr-CA": {"fr-CA", ",", nbsp, []int{3}, false, true},
+	"fr-CH": {"fr-CH", ".", nbsp, []int{3}, false, true},
+	"fr-FR": {"fr-FR", ",", nbsp, []int{3}, false, true},
+	"it-IT": {"it-IT", ",", ".", []int{3}, false, true},
+	"ja-JP": {"ja-JP", ".", ",", []int{3}, true, false},
+	"ko-KR": {"ko-KR", ".", ",", []int{3}, true, false},
+	"nb-NO": {"nb-NO", ",", nbsp, []int{3}, false, true},
+	"nl-NL": {"nl-NL", ",", ".", []int{3}, true, true},
+	"pl-PL": {"pl-PL", ",", nbsp, []int{3}, false, true},
+	"pt-BR": {"pt-BR", ",", ".", []int{3}, true, true},
+	"pt-PT": {"pt-PT", ",", nbsp, []int{3}, false, true},
+	"sv-SE": {"sv-SE", ",", nbsp, []int{3}, false, true},
+	"zh-CN": {"zh-CN", ".", ",", []int{3}, true, false},
+}
+
+// defaultRegions maps bare language tags to the locale used for them.
+var defaultRegions = map[string]string{
+	"en": "en-US",
+	"da": "da-DK",
+	"de": "de-DE",
+	"es": "es-ES",
+	"fi": "fi-FI",
+	"fr": "fr-FR",
+	"it": "it-IT",
+	"ja": "ja-JP",
+	"ko": "ko-KR",
+	"nb": "nb-NO",
+	"no": "nb-NO",
+	"nl": "nl-NL",
+	"pl": "pl-PL",
+	"pt": "pt-BR",
+	"sv": "sv-SE",
+	"zh": "zh-CN",
+}
+
+// LocaleFor returns the Locale for a BCP 47 tag such as "de-DE" or "fr_CA".
+// Unknown regions fall back to the default region of the language and
+// unknown languages fall back to EnUS.
+func LocaleFor(tag string) Locale {
+	tag = strings.Replace(strings.TrimSpace(tag), "_", "-", -1)
+
+	parts := strings.SplitN(tag, "-", 2)
+	lang := strings.ToLower(parts[0])
+	if len(parts) == 2 {
+		if l, ok := locales[lang+"-"+strings.ToUpper(parts[1])]; ok {
+			return l
+		}
+	}
+
+	if def, ok := defaultRegions[lang]; ok {
+		return locales[def]
+	}
+
+	return EnUS
+}
+
+// Format renders an amount given in Stripe minor units using the
+// conventions of the locale, e.g. "$1,234.56" or "1.234,56 €".
+func (l Locale) Format(m Money) string {
+	dec := m.Decimal()
+
+	neg := strings.HasPrefix(dec, "-")
+	if neg {
+		dec = dec[1:]
+	}
+
+	whole, frac := dec, ""
+	if i := strings.IndexByte(dec, '.'); i >= 0 {
+		whole, frac = dec[:i], dec[i+1:]
+	}
+
+	num := l.group(whole)
+	if len(frac) > 0 {
+		num += l.Decimal + frac
+	}
+
+	sep := ""
+	if l.SymbolSpace {
+		sep = nbsp
+	}
+
+	sym := Symbol(m.Currency)
+	if l.SymbolFirst {
+		num = sym + sep + num
+	} else {
+		num = num + sep + sym
+	}
+
+	if neg {
+		num = "-" + num
+	}
+
+	return num
+}
+
+// Parse converts an amount entered by a user in the conventions of the
+// locale, such as "1.234,50 €" for de-DE, to minor units of the currency.
+// Currency symbols, the currency code, whitespace and group separators
+// are ignored; a single minus sign before or after the digits, or
+// surrounding parentheses, mark a negative amount.
+func (l Locale) Parse(s string, c stripe.Currency) (Money, error) {
+	str := strings.TrimSpace(s)
+	for _, unit := range []string{Symbol(c), upper(c), string(normalize(c))} {
+		if len(unit) > 0 {
+			str = strings.Replace(str, unit, "", -1)
+		}
+	}
+
+	neg := false
+	if strings.HasPrefix(str, "(") && strings.HasSuffix(str, ")") {
+		neg = true
+		str = str[1 : len(str)-1]
+	}
+
+	// a single minus sign is accepted, before or after all the digits
+	signed, trailing, misplaced := neg, false, false
+
+	var b bytes.Buffer
+	write := func(r rune) {
+		misplaced = misplaced || trailing
+		b.WriteRune(r)
+	}
+
+	rest := str
+	for len(rest) > 0 {
+		switch {
+		case len(l.Decimal) > 0 && strings.HasPrefix(rest, l.Decimal):
+			write('.')
+			rest = rest[len(l.Decimal):]
+			continue
+		case len(l.Group) > 0 && strings.HasPrefix(rest, l.Group):
+			rest = rest[len(l.Group):]
+			continue
+		}
+
+		r := []rune(rest)[0]
+		rest = rest[len(string(r)):]
+
+		switch {
+		case r == '-':
+			misplaced = misplaced || signed
+			signed, neg, trailing = true, true, b.Len() > 0
+		case unicode.IsSpace(r), unicode.Is(unicode.Sc, r), r == '\'', r == '’':
+			// symbols, spaces and apostrophe group separators carry no value
+		default:
+			write(r)
+		}
+	}
+
+	m, err := Parse(b.String(), c)
+	if err != nil || misplaced {
+		return Money{}, fmt.Errorf("currency: cannot parse %q for %v in %v", s, upper(c), l.Tag)
+	}
+
+	if neg {
+		m = m.Neg()
+	}
+
+	return m, nil
+}
+
+// Format renders the amount for the locale identified by a BCP 47 tag.
+// For more details see Locale.Format.
+func (m Money) Format(tag string) string {
+	return LocaleFor(tag).Format(m)
+}
+
+// ParseLocale converts an amount entered by a user in the locale identified
+// by a BCP 47 tag to minor units. For more details see Locale.Parse.
+func ParseLocale(s string, c stripe.Currency, tag string) (Money, error) {
+	return LocaleFor(tag).Parse(s, c)
+}
+
+func (l Locale) group(digits string) string {
+	if len(l.Grouping) == 0 || len(l.Group) == 0 {
+		return digits
+	}
+
+	var groups []string
+	size := l.Grouping[0]
+	for i := 1; len(digits) > size; i++ {
+		groups = append([]string{digits[len(digits)-size:]}, groups...)
+		digits = digits[:len(digits)-size]
+
+		if i < len(l.Grouping) {
+			size = l.Grouping[i]
+		}
+	}
+	groups = append([]string{digits}, groups...)
+
+	return strings.Join(groups, l.Group)
+}
+
+func upper(c stripe.Currency) string {
+	return strings.ToUpper(string(c))
+}
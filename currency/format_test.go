@@ -0,0 +1,96 @@
+package currency
+
+import (
+	"testing"
+
+	stripe "github.com/channelmeter/stripe-go"
+)
+
+func TestLookup(t *testing.T) {
+	info, ok := Lookup("USD")
+	if !ok {
+		t.Fatalf("Lookup(USD) not found")
+	}
+
+	if info.Code != USD || info.Numeric != "840" || info.MinorUnits != 2 || info.Symbol != "$" {
+		t.Errorf("Lookup(USD) = %+v", info)
+	}
+
+	if info, _ := Lookup(JPY); info.MinorUnits != 0 {
+		t.Errorf("JPY minor units = %v want 0", info.MinorUnits)
+	}
+
+	if _, ok := Lookup("xxx"); ok {
+		t.Errorf("Lookup(xxx) should not be found")
+	}
+
+	if s := Symbol("xxx"); s != "XXX" {
+		t.Errorf("Symbol(xxx) = %q want %q", s, "XXX")
+	}
+}
+
+func TestFormat(t *testing.T) {
+	cases := []struct {
+		m      Money
+		locale string
+		want   string
+	}{
+		{New(123456, USD), "en-US", "$1,234.56"},
+		{New(-5, USD), "en-US", "-$0.05"},
+		{New(123456, EUR), "de-DE", "1.234,56\u00a0€"},
+		{New(123456, EUR), "fr_FR", "1\u00a0234,56\u00a0€"},
+		{New(123456, EUR), "nl", "€\u00a01.234,56"},
+		{New(1234567, JPY), "ja-JP", "¥1,234,567"},
+		{New(1234567, INR), "en-IN", "₹12,345.67"},
+		{New(1234567890, INR), "en-IN", "₹1,23,45,678.90"},
+		{New(100, GBP), "xx-YY", "£1.00"},
+	}
+
+	for _, c := range cases {
+		if got := c.m.Format(c.locale); got != c.want {
+			t.Errorf("%v.Format(%q) = %q want %q", c.m, c.locale, got, c.want)
+		}
+	}
+}
+
+func TestParseLocale(t *testing.T) {
+	cases := []struct {
+		in     string
+		cur    stripe.Currency
+		locale string
+		want   int64
+	}{
+		{"$1,234.56", USD, "en-US", 123456},
+		{"1234.5", USD, "en-US", 123450},
+		{"(12.00)", USD, "en-US", -1200},
+		{"-$12.00", USD, "en-US", -1200},
+		{"$-12.00", USD, "en-US", -1200},
+		{"12,00 €-", EUR, "de-DE", -1200},
+		{"1.234,56 €", EUR, "de-DE", 123456},
+		{"EUR 12,5", EUR, "de-DE", 1250},
+		{"1 234,56", EUR, "fr-FR", 123456},
+		{"CHF 1’234.50", CHF, "de-CH", 123450},
+		{"¥1,234", JPY, "ja-JP", 1234},
+	}
+
+	for _, c := range cases {
+		m, err := ParseLocale(c.in, c.cur, c.locale)
+		if err != nil {
+			t.Fatalf("ParseLocale(%q, %v, %q) error: %v", c.in, c.cur, c.locale, err)
+		}
+
+		if m.Amount != c.want {
+			t.Errorf("ParseLocale(%q, %v, %q) = %v want %v", c.in, c.cur, c.locale, m.Amount, c.want)
+		}
+	}
+
+	if _, err := ParseLocale("12,34", JPY, "de-DE"); err == nil {
+		t.Errorf("ParseLocale should reject decimals for JPY")
+	}
+
+	for _, in := range []string{"1-2", "--5", "-5-", "(-5)", "5-.00"} {
+		if _, err := ParseLocale(in, USD, "en-US"); err == nil {
+			t.Errorf("ParseLocale(%q) should reject a misplaced minus sign", in)
+		}
+	}
+}
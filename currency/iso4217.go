@@ -0,0 +1,175 @@
+package currency
+
+import (
+	stripe "github.com/channelmeter/stripe-go"
+)
+
+// Info is the ISO 4217 metadata for a currency.
+// MinorUnits is the exponent defined by ISO 4217, which can differ from
+// the one Stripe uses for amounts (see Exponent).
+type Info struct {
+	Code       stripe.Currency
+	Numeric    string
+	Name       string
+	MinorUnits int
+	Symbol     string
+}
+
+// Lookup returns the ISO 4217 metadata for a currency.
+// The second return value is false for unknown currencies.
+func Lookup(c stripe.Currency) (Info, bool) {
+	i, ok := iso4217[normalize(c)]
+	return i, ok
+}
+
+// Symbol returns the display symbol for a currency, falling back to the
+// upper-cased currency code when the currency is unknown.
+func Symbol(c stripe.Currency) string {
+	if i, ok := Lookup(c); ok {
+		return i.Symbol
+	}
+
+	return upper(c)
+}
+
+var iso4217 = map[stripe.Currency]Info{
+	AED: {AED, "784", "United Arab Emirates Dirham", 2, "د.إ"},
+	AFN: {AFN, "971", "Afghan Afghani", 2, "؋"},
+	ALL: {ALL, "008", "Albanian Lek", 2, "L"},
+	AMD: {AMD, "051", "Armenian Dram", 2, "֏"},
+	ANG: {ANG, "532", "Netherlands Antillean Gulden", 2, "ƒ"},
+	AOA: {AOA, "973", "Angolan Kwanza", 2, "Kz"},
+	ARS: {ARS, "032", "Argentine Peso", 2, "$"},
+	AUD: {AUD, "036", "Australian Dollar", 2, "A$"},
+	AWG: {AWG, "533", "Aruban Florin", 2, "ƒ"},
+	AZN: {AZN, "944", "Azerbaijani Manat", 2, "₼"},
+	BAM: {BAM, "977", "Bosnia & Herzegovina Convertible Mark", 2, "KM"},
+	BBD: {BBD, "052", "Barbadian Dollar", 2, "Bds$"},
+	BDT: {BDT, "050", "Bangladeshi Taka", 2, "৳"},
+	BGN: {BGN, "975", "Bulgarian Lev", 2, "лв"},
+	BIF: {BIF, "108", "Burundian Franc", 0, "FBu"},
+	BMD: {BMD, "060", "Bermudian Dollar", 2, "BD$"},
+	BND: {BND, "096", "Brunei Dollar", 2, "B$"},
+	BOB: {BOB, "068", "Bolivian Boliviano", 2, "Bs."},
+	BRL: {BRL, "986", "Brazilian Real", 2, "R$"},
+	BSD: {BSD, "044", "Bahamian Dollar", 2, "B$"},
+	BWP: {BWP, "072", "Botswana Pula", 2, "P"},
+	BZD: {BZD, "084", "Belize Dollar", 2, "BZ$"},
+	CAD: {CAD, "124", "Canadian Dollar", 2, "CA$"},
+	CDF: {CDF, "976", "Congolese Franc", 2, "FC"},
+	CHF: {CHF, "756", "Swiss Franc", 2, "CHF"},
+	CLP: {CLP, "152", "Chilean Peso", 0, "CLP$"},
+	CNY: {CNY, "156", "Chinese Renminbi Yuan", 2, "CN¥"},
+	COP: {COP, "170", "Colombian Peso", 2, "COL$"},
+	CRC: {CRC, "188", "Costa Rican Colón", 2, "₡"},
+	CVE: {CVE, "132", "Cape Verdean Escudo", 2, "Esc"},
+	CZK: {CZK, "203", "Czech Koruna", 2, "Kč"},
+	DJF: {DJF, "262", "Djiboutian Franc", 0, "Fdj"},
+	DKK: {DKK, "208", "Danish Krone", 2, "kr."},
+	DOP: {DOP, "214", "Dominican Peso", 2, "RD$"},
+	DZD: {DZD, "012", "Algerian Dinar", 2, "DA"},
+	EEK: {EEK, "233", "Estonian Kroon", 2, "kr"},
+	EGP: {EGP, "818", "Egyptian Pound", 2, "E£"},
+	ETB: {ETB, "230", "Ethiopian Birr", 2, "Br"},
+	EUR: {EUR, "978", "Euro", 2, "€"},
+	FJD: {FJD, "242", "Fijian Dollar", 2, "FJ$"},
+	FKP: {FKP, "238", "Falkland Islands Pound", 2, "£"},
+	GBP: {GBP, "826", "British Pound", 2, "£"},
+	GEL: {GEL, "981", "Georgian Lari", 2, "₾"},
+	GIP: {GIP, "292", "Gibraltar Pound", 2, "£"},
+	GMD: {GMD, "270", "Gambian Dalasi", 2, "D"},
+	GNF: {GNF, "324", "Guinean Franc", 0, "FG"},
+	GTQ: {GTQ, "320", "Guatemalan Quetzal", 2, "Q"},
+	GYD: {GYD, "328", "Guyanese Dollar", 2, "G$"},
+	HKD: {HKD, "344", "Hong Kong Dollar", 2, "HK$"},
+	HNL: {HNL, "340", "Honduran Lempira", 2, "L"},
+	HRK: {HRK, "191", "Croatian Kuna", 2, "kn"},
+	HTG: {HTG, "332", "Haitian Gourde", 2, "G"},
+	HUF: {HUF, "348", "Hungarian Forint", 2, "Ft"},
+	IDR: {IDR, "360", "Indonesian Rupiah", 2, "Rp"},
+	ILS: {ILS, "376", "Israeli New Sheqel", 2, "₪"},
+	INR: {INR, "356", "Indian Rupee", 2, "₹"},
+	ISK: {ISK, "352", "Icelandic Króna", 0, "kr"},
+	JMD: {JMD, "388", "Jamaican Dollar", 2, "J$"},
+	JPY: {JPY, "392", "Japanese Yen", 0, "¥"},
+	KES: {KES, "404", "Kenyan Shilling", 2, "KSh"},
+	KGS: {KGS, "417", "Kyrgyzstani Som", 2, "сом"},
+	KHR: {KHR, "116", "Cambodian Riel", 2, "៛"},
+	KMF: {KMF, "174", "Comorian Franc", 0, "CF"},
+	KRW: {KRW, "410", "South Korean Won", 0, "₩"},
+	KYD: {KYD, "136", "Cayman Islands Dollar", 2, "CI$"},
+	KZT: {KZT, "398", "Kazakhstani Tenge", 2, "₸"},
+	LAK: {LAK, "418", "Lao Kip", 2, "₭"},
+	LBP: {LBP, "422", "Lebanese Pound", 2, "L£"},
+	LKR: {LKR, "144", "Sri Lankan Rupee", 2, "Rs"},
+	LRD: {LRD, "430", "Liberian Dollar", 2, "L$"},
+	LSL: {LSL, "426", "Lesotho Loti", 2, "M"},
+	LTL: {LTL, "440", "Lithuanian Litas", 2, "Lt"},
+	LVL: {LVL, "428", "Latvian Lats", 2, "Ls"},
+	MAD: {MAD, "504", "Moroccan Dirham", 2, "MAD"},
+	MDL: {MDL, "498", "Moldovan Leu", 2, "L"},
+	MGA: {MGA, "969", "Malagasy Ariary", 2, "Ar"},
+	MKD: {MKD, "807", "Macedonian Denar", 2, "ден"},
+	MNT: {MNT, "496", "Mongolian Tögrög", 2, "₮"},
+	MOP: {MOP, "446", "Macanese Pataca", 2, "MOP$"},
+	MRO: {MRO, "478", "Mauritanian Ouguiya", 2, "UM"},
+	MUR: {MUR, "480", "Mauritian Rupee", 2, "₨"},
+	MVR: {MVR, "462", "Maldivian Rufiyaa", 2, "Rf"},
+	MWK: {MWK, "454", "Malawian Kwacha", 2, "MK"},
+	MXN: {MXN, "484", "Mexican Peso", 2, "MX$"},
+	MYR: {MYR, "458", "Malaysian Ringgit", 2, "RM"},
+	MZN: {MZN, "943", "Mozambican Metical", 2, "MT"},
+	NAD: {NAD, "516", "Namibian Dollar", 2, "N$"},
+	NGN: {NGN, "566", "Nigerian Naira", 2, "₦"},
+	NIO: {NIO, "558", "Nicaraguan Córdoba", 2, "C$"},
+	NOK: {NOK, "578", "Norwegian Krone", 2, "kr"},
+	NPR: {NPR, "524", "Nepalese Rupee", 2, "Rs"},
+	NZD: {NZD, "554", "New Zealand Dollar", 2, "NZ$"},
+	PAB: {PAB, "590", "Panamanian Balboa", 2, "B/."},
+	PEN: {PEN, "604", "Peruvian Nuevo Sol", 2, "S/."},
+	PGK: {PGK, "598", "Papua New Guinean Kina", 2, "K"},
+	PHP: {PHP, "608", "Philippine Peso", 2, "₱"},
+	PKR: {PKR, "586", "Pakistani Rupee", 2, "Rs"},
+	PLN: {PLN, "985", "Polish Złoty", 2, "zł"},
+	PYG: {PYG, "600", "Paraguayan Guaraní", 0, "₲"},
+	QAR: {QAR, "634", "Qatari Riyal", 2, "QR"},
+	RON: {RON, "946", "Romanian Leu", 2, "lei"},
+	RSD: {RSD, "941", "Serbian Dinar", 2, "дин."},
+	RUB: {RUB, "643", "Russian Ruble", 2, "₽"},
+	RWF: {RWF, "646", "Rwandan Franc", 0, "RF"},
+	SAR: {SAR, "682", "Saudi Riyal", 2, "SR"},
+	SBD: {SBD, "090", "Solomon Islands Dollar", 2, "SI$"},
+	SCR: {SCR, "690", "Seychellois Rupee", 2, "SR"},
+	SEK: {SEK, "752", "Swedish Krona", 2, "kr"},
+	SGD: {SGD, "702", "Singapore Dollar", 2, "S$"},
+	SHP: {SHP, "654", "Saint Helenian Pound", 2, "£"},
+	SLL: {SLL, "694", "Sierra Leonean Leone", 2, "Le"},
+	SOS: {SOS, "706", "Somali Shilling", 2, "Sh"},
+	SRD: {SRD, "968", "Surinamese Dollar", 2, "Sr$"},
+	STD: {STD, "678", "São Tomé and Príncipe Dobra", 2, "Db"},
+	SVC: {SVC, "222", "Salvadoran Colón", 2, "₡"},
+	SZL: {SZL, "748", "Swazi Lilangeni", 2, "E"},
+	THB: {THB, "764", "Thai Baht", 2, "฿"},
+	TJS: {TJS, "972", "Tajikistani Somoni", 2, "SM"},
+	TOP: {TOP, "776", "Tongan Paʻanga", 2, "T$"},
+	TRY: {TRY, "949", "Turkish Lira", 2, "₺"},
+	TTD: {TTD, "780", "Trinidad and Tobago Dollar", 2, "TT$"},
+	TWD: {TWD, "901", "New Taiwan Dollar", 2, "NT$"},
+	TZS: {TZS, "834", "Tanzanian Shilling", 2, "TSh"},
+	UAH: {UAH, "980", "Ukrainian Hryvnia", 2, "₴"},
+	UGX: {UGX, "800", "Ugandan Shilling", 0, "USh"},
+	USD: {USD, "840", "United States Dollar", 2, "$"},
+	UYU: {UYU, "858", "Uruguayan Peso", 2, "$U"},
+	UZS: {UZS, "860", "Uzbekistani Som", 2, "soʻm"},
+	VEF: {VEF, "937", "Venezuelan Bolívar", 2, "Bs.F"},
+	VND: {VND, "704", "Vietnamese Đồng", 0, "₫"},
+	VUV: {VUV, "548", "Vanuatu Vatu", 0, "VT"},
+	WST: {WST, "882", "Samoan Tala", 2, "WS$"},
+	XAF: {XAF, "950", "Central African Cfa Franc", 0, "FCFA"},
+	XCD: {XCD, "951", "East Caribbean Dollar", 2, "EC$"},
+	XOF: {XOF, "952", "West African Cfa Franc", 0, "CFA"},
+	XPF: {XPF, "953", "Cfp Franc", 0, "CFPF"},
+	YER: {YER, "886", "Yemeni Rial", 2, "YR"},
+	ZAR: {ZAR, "710", "South African Rand", 2, "R"},
+	ZMW: {ZMW, "967", "Zambian Kwacha", 2, "ZK"},
+}
@@ -0,0 +1,303 @@
+package currency
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	stripe "github.com/channelmeter/stripe-go"
+)
+
+// ErrMismatch is returned when an operation is attempted on two Money
+// values that are not in the same currency.
+var ErrMismatch = errors.New("currency: mismatched currencies")
+
+// ErrOverflow is returned when the result of an operation does not fit
+// in the minor units of a Money value.
+var ErrOverflow = errors.New("currency: amount overflow")
+
+// zeroDecimal is the list of currencies Stripe charges in whole units.
+// It follows the MinorUnits of ISO 4217 except for MGA, which Stripe charges
+// in whole units, and ISK, which it still charges in hundredths.
+// For more details see https://support.stripe.com/questions/which-zero-decimal-currencies-does-stripe-support.
+var zeroDecimal = map[stripe.Currency]bool{
+	BIF: true,
+	CLP: true,
+	DJF: true,
+	GNF: true,
+	JPY: true,
+	KMF: true,
+	KRW: true,
+	MGA: true,
+	PYG: true,
+	RWF: true,
+	UGX: true,
+	VND: true,
+	VUV: true,
+	XAF: true,
+	XOF: true,
+	XPF: true,
+}
+
+// minimumCharge is the smallest amount, in minor units, that Stripe accepts
+// for a charge in a given currency.
+// For more details see https://support.stripe.com/questions/what-is-the-minimum-amount-i-can-charge-with-stripe.
+var minimumCharge = map[stripe.Currency]int64{
+	AUD: 50,
+	CAD: 50,
+	CHF: 50,
+	DKK: 250,
+	EUR: 50,
+	GBP: 30,
+	HKD: 400,
+	JPY: 50,
+	MXN: 1000,
+	NOK: 300,
+	NZD: 50,
+	SEK: 300,
+	SGD: 50,
+	USD: 50,
+}
+
+// Money is an amount expressed in the minor units of its currency,
+// which is how Stripe represents every amount (cents for USD, yen for JPY).
+type Money struct {
+	Amount   int64
+	Currency stripe.Currency
+}
+
+// New returns a Money value for an amount already expressed in minor units.
+func New(amount int64, c stripe.Currency) Money {
+	return Money{Amount: amount, Currency: normalize(c)}
+}
+
+// Parse converts a decimal string such as "12.50" to a Money value in the
+// given currency. It returns an error if the string has more fractional
+// digits than the currency allows, so "1.5" is rejected for JPY.
+func Parse(s string, c stripe.Currency) (Money, error) {
+	c = normalize(c)
+	exp := Exponent(c)
+
+	str := strings.TrimSpace(s)
+	neg := false
+	if strings.HasPrefix(str, "-") || strings.HasPrefix(str, "+") {
+		neg = str[0] == '-'
+		str = str[1:]
+	}
+
+	whole, frac := str, ""
+	if i := strings.IndexByte(str, '.'); i >= 0 {
+		whole, frac = str[:i], str[i+1:]
+	}
+
+	if (len(whole) == 0 && len(frac) == 0) || !isDigits(whole) || !isDigits(frac) {
+		return Money{}, fmt.Errorf("currency: invalid amount %q", s)
+	}
+
+	if len(frac) > exp {
+		if strings.Trim(frac[exp:], "0") != "" {
+			return Money{}, fmt.Errorf("currency: amount %q has too many decimal places for %v", s, c)
+		}
+		frac = frac[:exp]
+	}
+	frac += strings.Repeat("0", exp-len(frac))
+
+	digits := strings.TrimLeft(whole+frac, "0")
+	if len(digits) == 0 {
+		return Money{Currency: c}, nil
+	}
+
+	amount, err := strconv.ParseInt(digits, 10, 64)
+	if err != nil {
+		return Money{}, ErrOverflow
+	}
+
+	if neg {
+		amount = -amount
+	}
+
+	return Money{Amount: amount, Currency: c}, nil
+}
+
+// Exponent returns the number of minor-unit digits Stripe uses for a currency,
+// which is 0 for zero-decimal currencies and 2 for every other one.
+func Exponent(c stripe.Currency) int {
+	if IsZeroDecimal(c) {
+		return 0
+	}
+
+	return 2
+}
+
+// IsZeroDecimal returns whether amounts in a currency are sent to Stripe
+// in whole units rather than hundredths.
+func IsZeroDecimal(c stripe.Currency) bool {
+	return zeroDecimal[normalize(c)]
+}
+
+// MinimumCharge returns the smallest chargeable amount in minor units
+// for a currency. The second return value is false when Stripe does not
+// publish a fixed minimum for the currency.
+func MinimumCharge(c stripe.Currency) (int64, bool) {
+	min, ok := minimumCharge[normalize(c)]
+	return min, ok
+}
+
+// Decimal returns the amount as a decimal string in major units,
+// e.g. "12.50" for 1250 USD or "1250" for 1250 JPY.
+func (m Money) Decimal() string {
+	exp := Exponent(m.Currency)
+
+	sign := ""
+	abs := uint64(m.Amount)
+	if m.Amount < 0 {
+		sign = "-"
+		abs = uint64(-m.Amount)
+	}
+
+	digits := strconv.FormatUint(abs, 10)
+	if exp == 0 {
+		return sign + digits
+	}
+
+	if len(digits) <= exp {
+		digits = strings.Repeat("0", exp-len(digits)+1) + digits
+	}
+
+	return sign + digits[:len(digits)-exp] + "." + digits[len(digits)-exp:]
+}
+
+// String returns the amount and the upper-cased currency code, e.g. "12.50 USD".
+func (m Money) String() string {
+	return m.Decimal() + " " + upper(m.Currency)
+}
+
+// IsZero returns whether the amount is zero.
+func (m Money) IsZero() bool {
+	return m.Amount == 0
+}
+
+// IsNegative returns whether the amount is below zero.
+func (m Money) IsNegative() bool {
+	return m.Amount < 0
+}
+
+// Uint64 returns the amount as the unsigned minor units used by most
+// *Params amount fields. Negative amounts return zero.
+func (m Money) Uint64() uint64 {
+	if m.Amount < 0 {
+		return 0
+	}
+
+	return uint64(m.Amount)
+}
+
+// Neg returns the amount with its sign flipped.
+func (m Money) Neg() Money {
+	return Money{Amount: -m.Amount, Currency: m.Currency}
+}
+
+// Add returns the sum of two amounts in the same currency.
+func (m Money) Add(o Money) (Money, error) {
+	if !m.SameCurrency(o) {
+		return Money{}, ErrMismatch
+	}
+
+	sum := m.Amount + o.Amount
+	if (o.Amount > 0 && sum < m.Amount) || (o.Amount < 0 && sum > m.Amount) {
+		return Money{}, ErrOverflow
+	}
+
+	return Money{Amount: sum, Currency: m.Currency}, nil
+}
+
+// Sub returns the difference of two amounts in the same currency.
+func (m Money) Sub(o Money) (Money, error) {
+	if o.Amount == math.MinInt64 {
+		return Money{}, ErrOverflow
+	}
+
+	return m.Add(o.Neg())
+}
+
+// Mul returns the amount multiplied by a whole quantity.
+func (m Money) Mul(n int64) (Money, error) {
+	if m.Amount != 0 && n != 0 {
+		p := m.Amount * n
+		if p/n != m.Amount || (m.Amount == -1 && n == math.MinInt64) || (n == -1 && m.Amount == math.MinInt64) {
+			return Money{}, ErrOverflow
+		}
+
+		return Money{Amount: p, Currency: m.Currency}, nil
+	}
+
+	return Money{Currency: m.Currency}, nil
+}
+
+// Cmp compares two amounts in the same currency and returns
+// -1 if m < o, 0 if m == o and +1 if m > o.
+func (m Money) Cmp(o Money) (int, error) {
+	if !m.SameCurrency(o) {
+		return 0, ErrMismatch
+	}
+
+	switch {
+	case m.Amount < o.Amount:
+		return -1, nil
+	case m.Amount > o.Amount:
+		return 1, nil
+	}
+
+	return 0, nil
+}
+
+// Equal returns whether both the amount and the currency are the same.
+func (m Money) Equal(o Money) bool {
+	return m.SameCurrency(o) && m.Amount == o.Amount
+}
+
+// SameCurrency returns whether two amounts are in the same currency.
+func (m Money) SameCurrency(o Money) bool {
+	return normalize(m.Currency) == normalize(o.Currency)
+}
+
+// ValidateCharge checks the amount against Stripe's minimum charge amount
+// for its currency. The returned error is a *stripe.Error carrying the
+// same type, code and param the API would return.
+func (m Money) ValidateCharge() error {
+	if m.Amount <= 0 {
+		return &stripe.Error{
+			Type:  stripe.InvalidRequest,
+			Msg:   "Amount must be a positive integer in the currency's smallest unit.",
+			Code:  stripe.InvalidAmount,
+			Param: "amount",
+		}
+	}
+
+	if min, ok := MinimumCharge(m.Currency); ok && m.Amount < min {
+		return &stripe.Error{
+			Type:  stripe.InvalidRequest,
+			Msg:   fmt.Sprintf("Amount must be at least %v", Money{Amount: min, Currency: m.Currency}),
+			Code:  stripe.AmountTooSmall,
+			Param: "amount",
+		}
+	}
+
+	return nil
+}
+
+func normalize(c stripe.Currency) stripe.Currency {
+	return stripe.Currency(strings.ToLower(string(c)))
+}
+
+func isDigits(s string) bool {
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+
+	return true
+}
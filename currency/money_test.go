@@ -0,0 +1,146 @@
+package currency
+
+import (
+	"testing"
+
+	stripe "github.com/channelmeter/stripe-go"
+)
+
+func TestMoneyParse(t *testing.T) {
+	cases := []struct {
+		in   string
+		cur  stripe.Currency
+		want int64
+	}{
+		{"12.50", USD, 1250},
+		{"12.5", USD, 1250},
+		{"12", USD, 1200},
+		{".99", USD, 99},
+		{"-3.01", EUR, -301},
+		{"1250", JPY, 1250},
+		{"1250.00", JPY, 1250},
+		{"0", KRW, 0},
+		{"1.230", USD, 123},
+	}
+
+	for _, c := range cases {
+		m, err := Parse(c.in, c.cur)
+		if err != nil {
+			t.Fatalf("Parse(%q, %v) error: %v", c.in, c.cur, err)
+		}
+
+		if m.Amount != c.want {
+			t.Errorf("Parse(%q, %v) = %v want %v", c.in, c.cur, m.Amount, c.want)
+		}
+	}
+
+	for _, in := range []string{"", ".", "1.2.3", "abc", "1.5", "1e3"} {
+		if _, err := Parse(in, JPY); err == nil {
+			t.Errorf("Parse(%q, JPY) expected an error", in)
+		}
+	}
+
+	if _, err := Parse("1.001", USD); err == nil {
+		t.Errorf("Parse should reject extra significant decimal places")
+	}
+}
+
+func TestMoneyDecimal(t *testing.T) {
+	cases := []struct {
+		m    Money
+		want string
+	}{
+		{New(1250, USD), "12.50"},
+		{New(5, USD), "0.05"},
+		{New(-5, USD), "-0.05"},
+		{New(1250, JPY), "1250"},
+		{New(0, EUR), "0.00"},
+	}
+
+	for _, c := range cases {
+		if got := c.m.Decimal(); got != c.want {
+			t.Errorf("%#v.Decimal() = %q want %q", c.m, got, c.want)
+		}
+	}
+
+	if got := New(1250, "USD").String(); got != "12.50 USD" {
+		t.Errorf("String() = %q want %q", got, "12.50 USD")
+	}
+}
+
+func TestMoneyArithmetic(t *testing.T) {
+	sum, err := New(150, USD).Add(New(50, USD))
+	if err != nil || !sum.Equal(New(200, USD)) {
+		t.Errorf("Add = %v, %v want 2.00 USD", sum, err)
+	}
+
+	diff, err := New(150, USD).Sub(New(200, USD))
+	if err != nil || diff.Amount != -50 {
+		t.Errorf("Sub = %v, %v want -0.50 USD", diff, err)
+	}
+
+	prod, err := New(150, JPY).Mul(3)
+	if err != nil || prod.Amount != 450 {
+		t.Errorf("Mul = %v, %v want 450 JPY", prod, err)
+	}
+
+	if _, err := New(150, USD).Add(New(150, JPY)); err != ErrMismatch {
+		t.Errorf("Add with mixed currencies err = %v want %v", err, ErrMismatch)
+	}
+
+	if _, err := New(150, USD).Cmp(New(150, EUR)); err != ErrMismatch {
+		t.Errorf("Cmp with mixed currencies err = %v want %v", err, ErrMismatch)
+	}
+
+	if c, _ := New(100, USD).Cmp(New(99, USD)); c != 1 {
+		t.Errorf("Cmp = %v want 1", c)
+	}
+
+	if _, err := New(1<<62, USD).Mul(4); err != ErrOverflow {
+		t.Errorf("Mul err = %v want %v", err, ErrOverflow)
+	}
+
+	if _, err := New(1<<62, USD).Add(New(1<<62, USD)); err != ErrOverflow {
+		t.Errorf("Add err = %v want %v", err, ErrOverflow)
+	}
+}
+
+func TestMoneyValidateCharge(t *testing.T) {
+	if err := New(50, USD).ValidateCharge(); err != nil {
+		t.Errorf("ValidateCharge(0.50 USD) = %v want nil", err)
+	}
+
+	err := New(49, USD).ValidateCharge()
+	if stripeErr, ok := err.(*stripe.Error); !ok || stripeErr.Code != stripe.AmountTooSmall {
+		t.Errorf("ValidateCharge(0.49 USD) = %v want %v", err, stripe.AmountTooSmall)
+	}
+
+	// 50 yen, not 0.50 yen
+	if err := New(50, JPY).ValidateCharge(); err != nil {
+		t.Errorf("ValidateCharge(50 JPY) = %v want nil", err)
+	}
+
+	if err := New(0, BRL).ValidateCharge(); err == nil {
+		t.Errorf("ValidateCharge(0 BRL) expected an error")
+	}
+}
+
+func TestZeroDecimalMinorUnits(t *testing.T) {
+	// the currencies whose Stripe exponent differs from ISO 4217
+	exceptions := map[stripe.Currency]bool{ISK: true, MGA: true}
+
+	for c, info := range iso4217 {
+		want := info.MinorUnits == 0
+		if exceptions[c] {
+			want = !want
+		}
+
+		if IsZeroDecimal(c) != want {
+			t.Errorf("IsZeroDecimal(%v) = %v want %v (ISO 4217 minor units %v)", c, !want, want, info.MinorUnits)
+		}
+	}
+
+	if m, err := Parse("1500", UGX); err != nil || m.Amount != 1500 {
+		t.Errorf("Parse(1500 UGX) = %v, %v want 1500", m, err)
+	}
+}
@@ -1,19 +1,53 @@
 package stripe
 
-import "encoding/json"
-
 // CustomerParams is the set of parameters that can be used when creating or updating a customer.
 // For more details see https://stripe.com/docs/api#create_customer and https://stripe.com/docs/api#update_customer.
 type CustomerParams struct {
 	Params
-	Balance       int64
-	Token, Coupon string
-	Source        *SourceParams
-	Desc, Email   string
-	Plan          string
-	Quantity      uint64
-	TrialEnd      int64
-	DefaultCard   string
+	Balance         int64                          `form:"account_balance"`
+	Token           string                         `form:"-"`
+	Coupon          string                         `form:"coupon"`
+	Source          *SourceParams                  `form:"source"`
+	Desc            string                         `form:"description"`
+	Email           string                         `form:"email"`
+	Plan            string                         `form:"plan"`
+	Quantity        uint64                         `form:"quantity"`
+	TrialEnd        Timestamp                      `form:"trial_end"`
+	DefaultCard     string                         `form:"default_card"`
+	PaymentMethod   string                         `form:"payment_method"`
+	InvoiceSettings *CustomerInvoiceSettingsParams `form:"invoice_settings"`
+}
+
+// CustomerInvoiceSettingsParams is the set of parameters for the default
+// invoice settings of a customer.
+type CustomerInvoiceSettingsParams struct {
+	DefaultPaymentMethod string `form:"default_payment_method"`
+}
+
+// Validate checks the customer parameters before they are sent.
+func (c *CustomerParams) Validate() error {
+	v := &validation{}
+	c.Params.validate(v)
+
+	if len(c.Plan) == 0 {
+		if c.Quantity > 0 {
+			v.add("quantity", "can only be used with a plan")
+		}
+
+		if c.TrialEnd > 0 {
+			v.add("trial_end", "can only be used with a plan")
+		}
+	}
+
+	if len(c.Token) > 0 && c.Source != nil {
+		v.add("source", "cannot be used together with a token")
+	}
+
+	if c.Source != nil {
+		v.nested(c.Source.Validate())
+	}
+
+	return v.err()
 }
 
 // SetSource adds valid sources to a CustomerParams object,
@@ -28,25 +62,43 @@ func (cp *CustomerParams) SetSource(sp interface{}) (error) {
 // For more details see https://stripe.com/docs/api#list_customers.
 type CustomerListParams struct {
 	ListParams
-	Created int64
+	Created      Timestamp    `form:"created"`
+	CreatedRange *RangeParams `form:"created"`
+}
+
+// Validate checks the customer list parameters before they are sent.
+func (p *CustomerListParams) Validate() error {
+	v := &validation{}
+	p.ListParams.validate(v)
+	v.timeRange("created", p.Created, p.CreatedRange)
+	return v.err()
 }
 
 // Customer is the resource representing a Stripe customer.
 // For more details see https://stripe.com/docs/api#customers.
 type Customer struct {
-	ID            string            `json:"id"`
-	Live          bool              `json:"livemode"`
-	Sources       *SourceList       `json:"sources"`
-	Created       int64             `json:"created"`
-	Balance       int64             `json:"account_balance"`
-	Currency      Currency          `json:"currency"`
-	DefaultSource *PaymentSource    `json:"default_source"`
-	Delinquent    bool              `json:"delinquent"`
-	Desc          string            `json:"description"`
-	Discount      *Discount         `json:"discount"`
-	Email         string            `json:"email"`
-	Meta          map[string]string `json:"metadata"`
-	Subs          *SubList          `json:"subscriptions"`
+	Extra
+	ID              string                   `json:"id"`
+	Live            bool                     `json:"livemode"`
+	Sources         *SourceList              `json:"sources"`
+	Created         Timestamp                `json:"created"`
+	Balance         int64                    `json:"account_balance"`
+	Currency        Currency                 `json:"currency"`
+	DefaultSource   *PaymentSource           `json:"default_source"`
+	Delinquent      bool                     `json:"delinquent"`
+	Desc            string                   `json:"description"`
+	Discount        *Discount                `json:"discount"`
+	Email           string                   `json:"email"`
+	InvoiceSettings *CustomerInvoiceSettings `json:"invoice_settings"`
+	Meta            map[string]string        `json:"metadata"`
+	Subs            *SubList                 `json:"subscriptions"`
+	TaxIDs          *TaxIDList               `json:"tax_ids"`
+	Expanded        bool                     `json:"-"`
+}
+
+// CustomerInvoiceSettings is the default invoice settings of a customer.
+type CustomerInvoiceSettings struct {
+	DefaultPaymentMethod *PaymentMethod `json:"default_payment_method"`
 }
 
 // UnmarshalJSON handles deserialization of a Customer.
@@ -54,14 +106,5 @@ type Customer struct {
 // property may be an id or the full struct if it was expanded.
 func (c *Customer) UnmarshalJSON(data []byte) error {
 	type customer Customer
-	var cc customer
-	err := json.Unmarshal(data, &cc)
-	if err == nil {
-		*c = Customer(cc)
-	} else {
-		// the id is surrounded by "\" characters, so strip them
-		c.ID = string(data[1 : len(data)-1])
-	}
-
-	return nil
+	return unmarshalExpandable(data, &c.ID, &c.Expanded, (*customer)(c))
 }
@@ -3,15 +3,18 @@ package customer
 
 import (
 	"net/url"
-	"strconv"
 
 	stripe "github.com/channelmeter/stripe-go"
+	"github.com/channelmeter/stripe-go/form"
 )
 
 // Client is used to invoke /customers APIs.
 type Client struct {
 	B   stripe.Backend
 	Key string
+	// NoValidate disables the client-side validation of params
+	// that is otherwise done before calling the backend.
+	NoValidate bool
 }
 
 // New POSTs new customers.
@@ -21,45 +24,17 @@ func New(params *stripe.CustomerParams) (*stripe.Customer, error) {
 }
 
 func (c Client) New(params *stripe.CustomerParams) (*stripe.Customer, error) {
+	if err := stripe.ValidateParams(params, c.NoValidate); err != nil {
+		return nil, err
+	}
+
 	var body *url.Values
 	var commonParams *stripe.Params
 
 	if params != nil {
-		body = &url.Values{}
-		if params.Balance != 0 {
-			body.Add("account_balance", strconv.FormatInt(params.Balance, 10))
-		}
-
-		if params.Source != nil {
-			params.Source.AppendDetails(body, true)
-		}
-
-		if len(params.Desc) > 0 {
-			body.Add("description", params.Desc)
-		}
-
-		if len(params.Coupon) > 0 {
-			body.Add("coupon", params.Coupon)
-		}
-
-		if len(params.Email) > 0 {
-			body.Add("email", params.Email)
-		}
-
-		if len(params.Plan) > 0 {
-			body.Add("plan", params.Plan)
-
-			if params.Quantity > 0 {
-				body.Add("quantity", strconv.FormatUint(params.Quantity, 10))
-			}
-
-			if params.TrialEnd > 0 {
-				body.Add("trial_end", strconv.FormatInt(params.TrialEnd, 10))
-			}
-		}
 		commonParams = &params.Params
-
-		params.AppendTo(body)
+		body = &url.Values{}
+		form.AppendTo(body, params)
 	}
 
 	cust := &stripe.Customer{}
@@ -97,37 +72,17 @@ func Update(id string, params *stripe.CustomerParams) (*stripe.Customer, error)
 }
 
 func (c Client) Update(id string, params *stripe.CustomerParams) (*stripe.Customer, error) {
+	if err := stripe.ValidateParams(params, c.NoValidate); err != nil {
+		return nil, err
+	}
+
 	var body *url.Values
 	var commonParams *stripe.Params
 
 	if params != nil {
 		commonParams = &params.Params
 		body = &url.Values{}
-
-		if params.Balance != 0 {
-			body.Add("account_balance", strconv.FormatInt(params.Balance, 10))
-		}
-
-		if params.Source != nil {
-			params.Source.AppendDetails(body, true)
-		}
-
-		if len(params.Desc) > 0 {
-			body.Add("description", params.Desc)
-		}
-
-		if len(params.Coupon) > 0 {
-			body.Add("coupon", params.Coupon)
-		}
-
-		if len(params.Email) > 0 {
-			body.Add("email", params.Email)
-		}
-
-		if len(params.DefaultCard) > 0 {
-			body.Add("default_card", params.DefaultCard)
-		}
-		params.AppendTo(body)
+		form.AppendTo(body, params)
 	}
 
 	cust := &stripe.Customer{}
@@ -153,6 +108,10 @@ func List(params *stripe.CustomerListParams) *Iter {
 }
 
 func (c Client) List(params *stripe.CustomerListParams) *Iter {
+	if err := stripe.ValidateParams(params, c.NoValidate); err != nil {
+		return &Iter{stripe.GetIterErr(err)}
+	}
+
 	type customerList struct {
 		stripe.ListMeta
 		Values []*stripe.Customer `json:"data"`
@@ -163,12 +122,7 @@ func (c Client) List(params *stripe.CustomerListParams) *Iter {
 
 	if params != nil {
 		body = &url.Values{}
-
-		if params.Created > 0 {
-			body.Add("created", strconv.FormatInt(params.Created, 10))
-		}
-
-		params.AppendTo(body)
+		form.AppendTo(body, params)
 		lp = &params.ListParams
 	}
 
@@ -199,5 +153,5 @@ func (i *Iter) Customer() *stripe.Customer {
 }
 
 func getC() Client {
-	return Client{stripe.GetBackend(stripe.APIBackend), stripe.Key}
+	return Client{B: stripe.GetBackend(stripe.APIBackend), Key: stripe.Key}
 }
@@ -0,0 +1,220 @@
+package stripe
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// StrictDecoding makes the decoding of a resource fail with an
+// *UnknownFieldsError when the API sends fields that the resource has no
+// member for. It is meant for tests that detect changes of the API.
+var StrictDecoding = false
+
+// Extra is embedded in every resource to hold the JSON it was decoded from,
+// so that fields added to the API after the version of the binding can
+// still be read.
+type Extra struct {
+	// Raw is the JSON object of the resource.
+	Raw json.RawMessage `json:"-"`
+
+	// typ is the type the resource was decoded as.
+	typ reflect.Type
+}
+
+// UnknownFieldsError is returned in strict decoding mode when a resource
+// holds fields that it has no member for.
+type UnknownFieldsError struct {
+	// Object is the type of the resource as given by the API.
+	Object string
+	Fields []string
+}
+
+var (
+	extraType = reflect.TypeOf(Extra{})
+
+	knownMu sync.RWMutex
+	known   = make(map[reflect.Type]map[string]bool)
+)
+
+// bufferPool holds the buffers used to read responses that are logged or
+// that carry an error, so that they are not allocated on every request.
+var bufferPool = sync.Pool{
+	New: func() interface{} { return new(bytes.Buffer) },
+}
+
+func getBuffer() *bytes.Buffer {
+	buf := bufferPool.Get().(*bytes.Buffer)
+	buf.Reset()
+	return buf
+}
+
+func putBuffer(buf *bytes.Buffer) {
+	bufferPool.Put(buf)
+}
+
+// unmarshalExpandable decodes an expandable resource, which the API sends
+// as its ID unless it was expanded into the full object. The object is
+// decoded into v, a pointer to an alias of the resource type without its
+// UnmarshalJSON method, while an ID is only set to id. expanded reports
+// which form was received. Any other JSON value is an error.
+func unmarshalExpandable(data []byte, id *string, expanded *bool, v interface{}) error {
+	if len(data) == 0 {
+		return &json.UnmarshalTypeError{Value: "empty input", Type: reflect.TypeOf(v).Elem()}
+	}
+
+	switch data[0] {
+	case '"':
+		return json.Unmarshal(data, id)
+
+	case '{':
+		if err := unmarshalResource(data, v); err != nil {
+			return err
+		}
+
+		*expanded = true
+		return nil
+
+	case 'n':
+		return json.Unmarshal(data, v)
+	}
+
+	return &json.UnmarshalTypeError{Value: jsonKind(data[0]), Type: reflect.TypeOf(v).Elem()}
+}
+
+// unmarshalResource decodes a resource into v, a pointer to an alias of
+// the resource type without its UnmarshalJSON method, and keeps its JSON in
+// the embedded Extra. Unknown fields are reported when StrictDecoding is set.
+func unmarshalResource(data []byte, v interface{}) error {
+	return decodeResource(data, v, StrictDecoding)
+}
+
+// decodeResource is unmarshalResource with the strict mode given by strict.
+func decodeResource(data []byte, v interface{}, strict bool) error {
+	// reset the resource first, as decoding an object into it only sets
+	// the properties that are present
+	rv := reflect.ValueOf(v).Elem()
+	rv.Set(reflect.Zero(rv.Type()))
+
+	if err := json.Unmarshal(data, v); err != nil {
+		return err
+	}
+
+	f := rv.FieldByName("Extra")
+	if !f.IsValid() || f.Type() != extraType || data[0] != '{' {
+		return nil
+	}
+
+	// data is only valid for the duration of the call
+	extra := f.Addr().Interface().(*Extra)
+	extra.Raw = append(json.RawMessage(nil), data...)
+	extra.typ = rv.Type()
+
+	if !strict {
+		return nil
+	}
+
+	unknown := extra.UnknownFields()
+	if len(unknown) == 0 {
+		return nil
+	}
+
+	var object struct {
+		Name string `json:"object"`
+	}
+	json.Unmarshal(data, &object)
+
+	err := &UnknownFieldsError{Object: object.Name}
+	for k := range unknown {
+		err.Fields = append(err.Fields, k)
+	}
+	sort.Strings(err.Fields)
+
+	return err
+}
+
+// UnknownFields returns the fields of the resource that it has no member
+// for, such as fields added to the API after the version of the binding.
+func (e *Extra) UnknownFields() map[string]json.RawMessage {
+	if len(e.Raw) == 0 || e.typ == nil {
+		return nil
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(e.Raw, &fields); err != nil {
+		return nil
+	}
+
+	k := knownFields(e.typ)
+	for name := range fields {
+		if k[strings.ToLower(name)] {
+			delete(fields, name)
+		}
+	}
+
+	return fields
+}
+
+// Error returns the type of the resource and its unknown fields.
+func (e *UnknownFieldsError) Error() string {
+	return fmt.Sprintf("Unknown fields for %v: %v", e.Object, strings.Join(e.Fields, ", "))
+}
+
+// knownFields returns the lowercased JSON names of the fields of the struct
+// type t, as encoding/json matches them regardless of case. The object
+// field, which only gives the type of a resource, is always known.
+func knownFields(t reflect.Type) map[string]bool {
+	knownMu.RLock()
+	k, ok := known[t]
+	knownMu.RUnlock()
+
+	if ok {
+		return k
+	}
+
+	k = map[string]bool{"object": true}
+	addKnownFields(k, t)
+
+	knownMu.Lock()
+	known[t] = k
+	knownMu.Unlock()
+
+	return k
+}
+
+func addKnownFields(k map[string]bool, t reflect.Type) {
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		name := strings.Split(sf.Tag.Get("json"), ",")[0]
+
+		if name == "-" || len(sf.PkgPath) > 0 && !sf.Anonymous {
+			continue
+		}
+
+		if sf.Anonymous && len(name) == 0 && sf.Type.Kind() == reflect.Struct {
+			addKnownFields(k, sf.Type)
+			continue
+		}
+
+		if len(name) == 0 {
+			name = sf.Name
+		}
+		k[strings.ToLower(name)] = true
+	}
+}
+
+// jsonKind describes the kind of the JSON value starting with c.
+func jsonKind(c byte) string {
+	switch c {
+	case '[':
+		return "array"
+	case 't', 'f':
+		return "bool"
+	}
+
+	return "number"
+}
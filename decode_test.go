@@ -0,0 +1,438 @@
+package stripe
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+// staticTransport answers every request with the same response.
+type staticTransport struct {
+	status int
+	body   []byte
+}
+
+func (t *staticTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	return &http.Response{
+		StatusCode: t.status,
+		Body:       ioutil.NopCloser(bytes.NewReader(t.body)),
+		Header:     make(http.Header),
+		Request:    req,
+	}, nil
+}
+
+func testBackend(status int, body []byte) *BackendConfiguration {
+	client := &http.Client{Transport: &staticTransport{status, body}}
+	return &BackendConfiguration{APIBackend, apiURL, client}
+}
+
+// chargePage returns a page of n charges, with their customer expanded when
+// expand is true.
+func chargePage(n int, expand bool) []byte {
+	var buf bytes.Buffer
+	buf.WriteString(`{"object":"list","url":"/v1/charges","has_more":true,"data":[`)
+
+	for i := 0; i < n; i++ {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+
+		customer := fmt.Sprintf(`"cus_%d"`, i)
+		if expand {
+			customer = fmt.Sprintf(`{"id":"cus_%d","object":"customer","email":"jenny@example.com","metadata":{"plan":"gold"}}`, i)
+		}
+
+		fmt.Fprintf(&buf, `{"id":"ch_%d","object":"charge","created":1430438400,"livemode":false,`+
+			`"paid":true,"amount":2000,"currency":"usd","refunded":false,"captured":true,`+
+			`"refunds":{"object":"list","has_more":false,"url":"/v1/charges/ch_%d/refunds","data":[]},`+
+			`"source":{"id":"card_%d","object":"card","brand":"Visa","last4":"4242","exp_month":8,"exp_year":2019},`+
+			`"customer":%s,"description":"Charge %d","metadata":{"order":"%d"}}`, i, i, i, customer, i, i)
+	}
+
+	buf.WriteString(`]}`)
+	return buf.Bytes()
+}
+
+func TestUnmarshalExpandable(t *testing.T) {
+	var ch Charge
+	if err := json.Unmarshal([]byte(`"ch_123"`), &ch); err != nil {
+		t.Fatalf("Unmarshal() err = %v want nil", err)
+	}
+	if ch.ID != "ch_123" || ch.Expanded {
+		t.Errorf("charge = %v, expanded %v want ch_123, false", ch.ID, ch.Expanded)
+	}
+
+	ch = Charge{Desc: "stale"}
+	if err := json.Unmarshal([]byte(`{"id":"ch_123","amount":100}`), &ch); err != nil {
+		t.Fatalf("Unmarshal() err = %v want nil", err)
+	}
+	if ch.ID != "ch_123" || ch.Amount != 100 || len(ch.Desc) > 0 || !ch.Expanded {
+		t.Errorf("charge = %+v want ch_123 expanded", ch)
+	}
+
+	for _, data := range []string{`123`, `["ch_123"]`, `{"id":`, `{"id":"ch_123","amount":"lots"}`, `{"customer":true}`} {
+		if err := json.Unmarshal([]byte(data), &ch); err == nil {
+			t.Errorf("Unmarshal(%v) err = nil want an error", data)
+		}
+	}
+
+	var owner struct {
+		Customer *Customer `json:"customer"`
+	}
+	if err := json.Unmarshal([]byte(`{"customer":null}`), &owner); err != nil || owner.Customer != nil {
+		t.Errorf("Unmarshal(null) = %v, %v want nil, nil", owner.Customer, err)
+	}
+}
+
+func TestDoDecodesList(t *testing.T) {
+	list := &ChargeList{}
+	if err := testBackend(200, chargePage(3, true)).Call("GET", "/charges", "sk_test", nil, nil, list); err != nil {
+		t.Fatalf("Call() err = %v want nil", err)
+	}
+
+	if len(list.Values) != 3 || !list.More {
+		t.Fatalf("list = %v values, more %v want 3 values, more true", len(list.Values), list.More)
+	}
+
+	ch := list.Values[2]
+	if ch.ID != "ch_2" || ch.Customer.ID != "cus_2" || ch.Customer.Email != "jenny@example.com" || !ch.Customer.Expanded {
+		t.Errorf("charge = %v with customer %+v", ch.ID, ch.Customer)
+	}
+
+	list = &ChargeList{}
+	if err := testBackend(200, chargePage(1, false)).Call("GET", "/charges", "sk_test", nil, nil, list); err != nil {
+		t.Fatalf("Call() err = %v want nil", err)
+	}
+
+	if ch := list.Values[0]; ch.Customer.ID != "cus_0" || len(ch.Customer.Email) > 0 || ch.Customer.Expanded {
+		t.Errorf("customer = %+v want only the ID", ch.Customer)
+	}
+}
+
+func TestDoReturnsErrors(t *testing.T) {
+	body := []byte(`{"error":{"type":"card_error","message":"Your card was declined.","code":"card_declined","param":"number"}}`)
+
+	err := testBackend(402, body).Call("POST", "/charges", "sk_test", nil, nil, &Charge{})
+	serr, ok := err.(*Error)
+	if !ok {
+		t.Fatalf("err = %v want a *Error", err)
+	}
+
+	if serr.Type != CardErr || serr.Code != "card_declined" || serr.Param != "number" || serr.HTTPStatusCode != 402 {
+		t.Errorf("err = %+v", serr)
+	}
+
+	err = testBackend(500, []byte("oops")).Call("GET", "/charges", "sk_test", nil, nil, &Charge{})
+	if err == nil || err.Error() != "oops" {
+		t.Errorf("err = %v want oops", err)
+	}
+}
+
+func TestDoReturnsDecodeErrors(t *testing.T) {
+	body := []byte(`{"id":"ch_123","amount":"lots"}`)
+
+	if err := testBackend(200, body).Call("GET", "/charges/ch_123", "sk_test", nil, nil, &Charge{}); err == nil {
+		t.Errorf("err = nil want a decoding error")
+	}
+}
+
+// readerTransport answers a request with the content of its reader.
+type readerTransport struct {
+	body *bytes.Reader
+}
+
+func (t *readerTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	return &http.Response{StatusCode: 200, Body: ioutil.NopCloser(t.body), Header: make(http.Header), Request: req}, nil
+}
+
+func TestDoDrainsBody(t *testing.T) {
+	transport := &readerTransport{bytes.NewReader([]byte("{\"id\":\"ch_123\",\"object\":\"charge\"}\n\n"))}
+	backend := &BackendConfiguration{APIBackend, apiURL, &http.Client{Transport: transport}}
+
+	ch := &Charge{}
+	if err := backend.Call("GET", "/charges/ch_123", "sk_test", nil, nil, ch); err != nil || ch.ID != "ch_123" {
+		t.Fatalf("Call() = %v, %v want ch_123, nil", ch.ID, err)
+	}
+
+	if n := transport.body.Len(); n > 0 {
+		t.Errorf("%v bytes left unread want the body drained", n)
+	}
+}
+
+func benchmarkDecode(b *testing.B, expand bool) {
+	data := chargePage(100, expand)
+	b.SetBytes(int64(len(data)))
+	b.ReportAllocs()
+
+	for i := 0; i < b.N; i++ {
+		list := &ChargeList{}
+		if err := json.Unmarshal(data, list); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkDecodeChargeList(b *testing.B) {
+	benchmarkDecode(b, false)
+}
+
+func BenchmarkDecodeChargeListExpanded(b *testing.B) {
+	benchmarkDecode(b, true)
+}
+
+func BenchmarkDoChargeList(b *testing.B) {
+	level := LogLevel
+	LogLevel = 0
+	defer func() { LogLevel = level }()
+
+	data := chargePage(100, true)
+	backend := testBackend(200, data)
+	b.SetBytes(int64(len(data)))
+	b.ReportAllocs()
+
+	for i := 0; i < b.N; i++ {
+		list := &ChargeList{}
+		if err := backend.Call("GET", "/charges", "sk_test", nil, nil, list); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func TestUnknownFields(t *testing.T) {
+	data := []byte(`{"id":"ch_123","object":"charge","amount":100,"outcome":{"type":"authorized"},` +
+		`"source":{"id":"card_123","object":"card","last4":"4242","wallet":"apple_pay"}}`)
+
+	var ch Charge
+	if err := json.Unmarshal(data, &ch); err != nil {
+		t.Fatalf("Unmarshal() err = %v want nil", err)
+	}
+
+	if !bytes.Equal(ch.Raw, data) {
+		t.Errorf("Raw = %s want %s", ch.Raw, data)
+	}
+
+	unknown := ch.UnknownFields()
+	if len(unknown) != 1 || string(unknown["outcome"]) != `{"type":"authorized"}` {
+		t.Errorf("UnknownFields() = %v want outcome", unknown)
+	}
+
+	unknown = ch.Source.UnknownFields()
+	if len(unknown) != 1 || string(unknown["wallet"]) != `"apple_pay"` {
+		t.Errorf("Source.UnknownFields() = %v want wallet", unknown)
+	}
+}
+
+func TestStrictDecoding(t *testing.T) {
+	StrictDecoding = true
+	defer func() { StrictDecoding = false }()
+
+	var ch Charge
+	if err := json.Unmarshal([]byte(`{"id":"ch_123","object":"charge","Amount":100}`), &ch); err != nil {
+		t.Fatalf("Unmarshal() err = %v want nil", err)
+	}
+
+	err := json.Unmarshal([]byte(`{"id":"ch_123","object":"charge","review":"prv_123","outcome":null}`), &ch)
+	uerr, ok := err.(*UnknownFieldsError)
+	if !ok {
+		t.Fatalf("err = %v want an *UnknownFieldsError", err)
+	}
+
+	if uerr.Object != "charge" || !reflect.DeepEqual(uerr.Fields, []string{"outcome", "review"}) {
+		t.Errorf("err = %+v want charge outcome, review", uerr)
+	}
+
+	var plan Plan
+	if err := json.Unmarshal([]byte(`{"id":"gold","object":"plan","tiers":null}`), &plan); err == nil {
+		t.Errorf("err = nil want an *UnknownFieldsError")
+	}
+}
+
+func TestUnmarshalPaymentIntent(t *testing.T) {
+	data := []byte(`{"id":"pi_123","object":"payment_intent","amount":2000,"currency":"usd",` +
+		`"status":"requires_action","customer":"cus_123",` +
+		`"next_action":{"type":"redirect_to_url","redirect_to_url":{"url":"https://hooks.stripe.com/3d","return_url":"https://example.com"}},` +
+		`"last_payment_error":{"type":"card_error","code":"card_declined","decline_code":"insufficient_funds","message":"Declined"},` +
+		`"charges":{"object":"list","has_more":false,"url":"/v1/charges","data":[{"id":"ch_123","object":"charge"}]}}`)
+
+	var pi PaymentIntent
+	if err := json.Unmarshal(data, &pi); err != nil {
+		t.Fatalf("Unmarshal() err = %v want nil", err)
+	}
+
+	if pi.ID != "pi_123" || pi.Status != "requires_action" || pi.Customer.ID != "cus_123" {
+		t.Errorf("payment intent = %+v", pi)
+	}
+	if pi.NextAction == nil || pi.NextAction.Type != "redirect_to_url" || pi.NextAction.RedirectToURL.URL != "https://hooks.stripe.com/3d" {
+		t.Errorf("next action = %+v", pi.NextAction)
+	}
+	if pi.LastPaymentError == nil || pi.LastPaymentError.Code != CardDeclined || pi.LastPaymentError.DeclineCode != "insufficient_funds" {
+		t.Errorf("last payment error = %+v", pi.LastPaymentError)
+	}
+	if pi.Charges == nil || len(pi.Charges.Values) != 1 || pi.Charges.Values[0].ID != "ch_123" {
+		t.Errorf("charges = %+v", pi.Charges)
+	}
+}
+
+func TestUnmarshalSetupIntent(t *testing.T) {
+	data := []byte(`{"id":"seti_123","object":"setup_intent","status":"succeeded","usage":"off_session",` +
+		`"customer":{"id":"cus_123","object":"customer","invoice_settings":{"default_payment_method":"pm_123"}},` +
+		`"payment_method":{"id":"pm_123","object":"payment_method","type":"card",` +
+		`"card":{"brand":"visa","last4":"4242","exp_month":8,"exp_year":2030,"three_d_secure_usage":{"supported":true}}}}`)
+
+	var si SetupIntent
+	if err := json.Unmarshal(data, &si); err != nil {
+		t.Fatalf("Unmarshal() err = %v want nil", err)
+	}
+
+	if si.ID != "seti_123" || si.Status != "succeeded" || si.Usage != "off_session" {
+		t.Errorf("setup intent = %+v", si)
+	}
+	if pm := si.PaymentMethod; pm == nil || !pm.Expanded || pm.Card.LastFour != "4242" || !pm.Card.ThreeDSecureUsage.Supported {
+		t.Errorf("payment method = %+v", pm)
+	}
+	if s := si.Customer.InvoiceSettings; s == nil || s.DefaultPaymentMethod.ID != "pm_123" || s.DefaultPaymentMethod.Expanded {
+		t.Errorf("invoice settings = %+v", s)
+	}
+}
+
+func TestUnmarshalPrice(t *testing.T) {
+	data := []byte(`{"id":"price_123","object":"price","type":"recurring","billing_scheme":"tiered",` +
+		`"product":"prod_123","recurring":{"interval":"month","interval_count":1},` +
+		`"tiers":[{"up_to":10,"unit_amount":500,"flat_amount":null},{"up_to":null,"unit_amount":400,"flat_amount":100}]}`)
+
+	var p Price
+	if err := json.Unmarshal(data, &p); err != nil {
+		t.Fatalf("Unmarshal() err = %v want nil", err)
+	}
+
+	if p.ID != "price_123" || p.Product.ID != "prod_123" || p.Recurring.Interval != "month" {
+		t.Errorf("price = %+v", p)
+	}
+
+	want := []*PriceTier{{UpTo: 10, UnitAmount: 500}, {UpToInf: true, UnitAmount: 400, FlatAmount: 100}}
+	if !reflect.DeepEqual(p.Tiers, want) {
+		t.Errorf("tiers = %+v %+v want %+v %+v", p.Tiers[0], p.Tiers[1], want[0], want[1])
+	}
+}
+
+func TestEventCheckoutSession(t *testing.T) {
+	data := []byte(`{"id":"evt_123","object":"event","type":"checkout.session.completed","data":{"object":` +
+		`{"id":"cs_123","object":"checkout.session","mode":"payment","payment_status":"paid",` +
+		`"customer":"cus_123","payment_intent":"pi_123","client_reference_id":"order_6735"}}}`)
+
+	var e Event
+	if err := json.Unmarshal(data, &e); err != nil {
+		t.Fatalf("Unmarshal() err = %v want nil", err)
+	}
+
+	s, err := e.CheckoutSession()
+	if err != nil {
+		t.Fatalf("CheckoutSession() err = %v want nil", err)
+	}
+
+	if s.ID != "cs_123" || s.PaymentStatus != "paid" || s.PaymentIntent.ID != "pi_123" || s.ClientReferenceID != "order_6735" {
+		t.Errorf("session = %+v", s)
+	}
+
+	e.Data.Obj["object"] = "charge"
+	if _, err := e.CheckoutSession(); err == nil {
+		t.Error("CheckoutSession() err = nil want an error for a charge event")
+	}
+}
+
+func TestUnmarshalPayout(t *testing.T) {
+	data := []byte(`{"id":"po_123","object":"payout","amount":1100,"currency":"usd","status":"in_transit",` +
+		`"arrival_date":1430438400,"balance_transaction":"txn_123","method":"standard","type":"bank_account",` +
+		`"destination":{"id":"ba_123","object":"bank_account","last4":"6789","bank_name":"STRIPE TEST BANK"}}`)
+
+	var p Payout
+	if err := json.Unmarshal(data, &p); err != nil {
+		t.Fatalf("Unmarshal() err = %v want nil", err)
+	}
+
+	if p.ID != "po_123" || p.Status != "in_transit" || p.Arrival != 1430438400 || p.Tx.ID != "txn_123" {
+		t.Errorf("payout = %+v", p)
+	}
+	if p.Dest == nil || p.Dest.Type != PaymentSourceBank || p.Dest.BankAccount.ID != "ba_123" {
+		t.Errorf("destination = %+v", p.Dest)
+	}
+}
+
+func TestUnmarshalInvoiceStatus(t *testing.T) {
+	data := []byte(`{"id":"in_123","object":"invoice","status":"void","auto_advance":false,"number":"ABC-0001",` +
+		`"status_transitions":{"finalized_at":1430438400,"voided_at":1430524800,"paid_at":null}}`)
+
+	var inv Invoice
+	if err := json.Unmarshal(data, &inv); err != nil {
+		t.Fatalf("Unmarshal() err = %v want nil", err)
+	}
+
+	if inv.Status != "void" || inv.Number != "ABC-0001" {
+		t.Errorf("invoice = %+v", inv)
+	}
+	if tr := inv.Transitions; tr == nil || tr.Finalized != 1430438400 || tr.Voided != 1430524800 || tr.Paid != 0 {
+		t.Errorf("transitions = %+v", inv.Transitions)
+	}
+}
+
+func TestUnmarshalCreditNote(t *testing.T) {
+	data := []byte(`{"id":"cn_123","object":"credit_note","amount":1500,"currency":"usd","invoice":"in_123",` +
+		`"refund":{"id":"re_123","object":"refund","amount":1000},"status":"issued","type":"post_payment",` +
+		`"lines":{"object":"list","has_more":false,"data":[{"id":"cnli_123","object":"credit_note_line_item",` +
+		`"type":"invoice_line_item","invoice_line_item":"il_123","amount":1500}]}}`)
+
+	var cn CreditNote
+	if err := json.Unmarshal(data, &cn); err != nil {
+		t.Fatalf("Unmarshal() err = %v want nil", err)
+	}
+
+	if cn.ID != "cn_123" || cn.Status != "issued" || cn.Type != "post_payment" || cn.Invoice.ID != "in_123" {
+		t.Errorf("credit note = %+v", cn)
+	}
+	if cn.Refund == nil || !cn.Refund.Expanded || cn.Refund.Amount != 1000 {
+		t.Errorf("refund = %+v", cn.Refund)
+	}
+	if cn.Lines == nil || len(cn.Lines.Values) != 1 || cn.Lines.Values[0].InvoiceLine != "il_123" {
+		t.Errorf("lines = %+v", cn.Lines)
+	}
+}
+
+func TestUnmarshalInvoiceTaxAmounts(t *testing.T) {
+	data := []byte(`{"id":"in_123","object":"invoice","tax":190,"default_tax_rates":[{"id":"txr_123","object":"tax_rate",` +
+		`"percentage":19,"inclusive":false,"jurisdiction":"DE"}],"total_tax_amounts":[{"amount":190,"inclusive":false,` +
+		`"tax_rate":"txr_123"}],"lines":{"object":"list","data":[{"id":"il_123","object":"line_item","amount":1000,` +
+		`"tax_rates":[],"tax_amounts":[{"amount":190,"inclusive":false,"tax_rate":"txr_123"}]}]}}`)
+
+	var inv Invoice
+	if err := json.Unmarshal(data, &inv); err != nil {
+		t.Fatalf("Unmarshal() err = %v want nil", err)
+	}
+
+	if len(inv.DefaultTaxRates) != 1 || !inv.DefaultTaxRates[0].Expanded || inv.DefaultTaxRates[0].Percentage != 19 {
+		t.Errorf("default tax rates = %+v", inv.DefaultTaxRates)
+	}
+	if len(inv.TaxAmounts) != 1 || inv.TaxAmounts[0].Amount != 190 || inv.TaxAmounts[0].TaxRate.ID != "txr_123" {
+		t.Errorf("tax amounts = %+v", inv.TaxAmounts)
+	}
+	if line := inv.Lines.Values[0]; len(line.TaxAmounts) != 1 || line.TaxAmounts[0].TaxRate.Expanded {
+		t.Errorf("line tax amounts = %+v", line.TaxAmounts)
+	}
+}
+
+func TestUnmarshalTaxID(t *testing.T) {
+	data := []byte(`{"id":"txi_123","object":"tax_id","country":"DE","customer":"cus_123","type":"eu_vat",` +
+		`"value":"DE123456789","verification":{"status":"verified","verified_name":"Jenny GmbH","verified_address":null}}`)
+
+	var id TaxID
+	if err := json.Unmarshal(data, &id); err != nil {
+		t.Fatalf("Unmarshal() err = %v want nil", err)
+	}
+
+	if id.Type != "eu_vat" || id.Customer.ID != "cus_123" || id.Verification.Status != "verified" {
+		t.Errorf("tax ID = %+v", id)
+	}
+}
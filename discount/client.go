@@ -34,5 +34,5 @@ func (c Client) DelSub(customerID, subscriptionID string) error {
 }
 
 func getC() Client {
-	return Client{stripe.GetBackend(stripe.APIBackend), stripe.Key}
+	return Client{B: stripe.GetBackend(stripe.APIBackend), Key: stripe.Key}
 }
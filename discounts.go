@@ -3,9 +3,16 @@ package stripe
 // Discount is the resource representing a Stripe discount.
 // For more details see https://stripe.com/docs/api#discounts.
 type Discount struct {
-	Coupon   *Coupon `json:"coupon"`
-	Customer string  `json:"customer"`
-	Start    int64   `json:"start"`
-	End      int64   `json:"end"`
-	Sub      string  `json:"subscription"`
+	Extra
+	Coupon   *Coupon   `json:"coupon"`
+	Customer string    `json:"customer"`
+	Start    Timestamp `json:"start"`
+	End      Timestamp `json:"end"`
+	Sub      string    `json:"subscription"`
+}
+
+// UnmarshalJSON handles deserialization of a Discount.
+func (d *Discount) UnmarshalJSON(data []byte) error {
+	type discount Discount
+	return unmarshalResource(data, (*discount)(d))
 }
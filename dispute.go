@@ -1,10 +1,5 @@
 package stripe
 
-import (
-	"encoding/json"
-	"net/url"
-)
-
 // DisputeReason is the list of allowed values for a discount's reason.
 // Allowed values are "duplicate", "fraudulent", "subscription_canceled",
 // "product_unacceptable", "product_not_received", "unrecognized",
@@ -21,30 +16,49 @@ type DisputeStatus string
 // For more details see https://stripe.com/docs/api#update_dispute.
 type DisputeParams struct {
 	Params
-	Evidence *DisputeEvidenceParams
+	Evidence *DisputeEvidenceParams `form:"evidence"`
 }
 
 // DisputeEvidenceParams is the set of parameters that can be used when submitting
 // evidence for disputes.
 type DisputeEvidenceParams struct {
-	ProductDesc, CustomerName, CustomerEmail, CustomerIP, CustomerSig, BillingAddress, Receipt string
-	ShippingAddress, ShippingDate, ShippingTracking, ShippingDoc                               string
-	RefundPolicy, RefundPolicyDisclosure, RefundRefusalReason                                  string
-	CancellationPolicy, CancellationPolicyDisclsoure, CancellationRebuttal                     string
-	ActivityLog                                                                                string
-	ServiceDate, ServiceDoc                                                                    string
-	DuplicateCharge, DuplicateChargeReason, DuplicateChargeDoc                                 string
-	CustomerComm, UncategorizedText, UncategorizedFile                                         string
+	ProductDesc                  string `form:"product_description"`
+	CustomerName                 string `form:"customer_name"`
+	CustomerEmail                string `form:"customer_email_address"`
+	CustomerIP                   string `form:"customer_purchase_ip"`
+	CustomerSig                  string `form:"customer_signature"`
+	BillingAddress               string `form:"billing_address"`
+	Receipt                      string `form:"receipt"`
+	ShippingAddress              string `form:"shipping_address"`
+	ShippingDate                 string `form:"shipping_date"`
+	ShippingTracking             string `form:"shipping_tracking_number"`
+	ShippingDoc                  string `form:"shipping_documentation"`
+	RefundPolicy                 string `form:"refund_policy"`
+	RefundPolicyDisclosure       string `form:"refund_policy_disclosure"`
+	RefundRefusalReason          string `form:"refund_refusal_explanation"`
+	CancellationPolicy           string `form:"cancellation_policy"`
+	CancellationPolicyDisclsoure string `form:"cancellation_policy_disclosure"`
+	CancellationRebuttal         string `form:"cancellation_rebuttal"`
+	ActivityLog                  string `form:"access_activity_log"`
+	ServiceDate                  string `form:"service_date"`
+	ServiceDoc                   string `form:"service_documentation"`
+	DuplicateCharge              string `form:"duplicate_charge_id"`
+	DuplicateChargeReason        string `form:"duplicate_charge_explanation"`
+	DuplicateChargeDoc           string `form:"duplicate_charge_documentation"`
+	CustomerComm                 string `form:"customer_communication"`
+	UncategorizedText            string `form:"uncategorized_text"`
+	UncategorizedFile            string `form:"uncategorized_file"`
 }
 
 // Dispute is the resource representing a Stripe dispute.
 // For more details see https://stripe.com/docs/api#disputes.
 type Dispute struct {
+	Extra
 	Live            bool              `json:"livemode"`
 	Amount          uint64            `json:"amount"`
 	Currency        Currency          `json:"currency"`
 	Charge          string            `json:"charge"`
-	Created         int64             `json:"created"`
+	Created         Timestamp         `json:"created"`
 	Refundable      bool              `json:"is_charge_refundable"`
 	Reason          DisputeReason     `json:"reason"`
 	Status          DisputeStatus     `json:"status"`
@@ -54,11 +68,17 @@ type Dispute struct {
 	Meta            map[string]string `json:"metadata"`
 }
 
+// UnmarshalJSON handles deserialization of a Dispute.
+func (d *Dispute) UnmarshalJSON(data []byte) error {
+	type dispute Dispute
+	return unmarshalResource(data, (*dispute)(d))
+}
+
 // EvidenceDetails is the structure representing more details about
 // the dispute.
 type EvidenceDetails struct {
-	DueDate int64 `json:"due_by"`
-	Count   int   `json:"submission_count"`
+	DueDate Timestamp `json:"due_by"`
+	Count   int       `json:"submission_count"`
 }
 
 // DisputeEvidence is the structure that contains various details about
@@ -96,119 +116,14 @@ type DisputeEvidence struct {
 
 // File represents a link to downloadable content.
 type File struct {
-	ID      string `json:"id"`
-	Created int64  `json:"created"`
-	Size    int    `json:"size"`
-	Purpose string `json:"purpose"`
-	URL     string `json:"url"`
-	Mime    string `json:"mime_type"`
-}
-
-// AppendDetails adds the dispute evidence details to the query string values.
-func (e *DisputeEvidenceParams) AppendDetails(values *url.Values) {
-	if len(e.ProductDesc) > 0 {
-		values.Add("evidence[product_description]", e.ProductDesc)
-	}
-
-	if len(e.CustomerName) > 0 {
-		values.Add("evidence[customer_name]", e.CustomerName)
-	}
-
-	if len(e.CustomerEmail) > 0 {
-		values.Add("evidence[customer_email_address]", e.CustomerEmail)
-	}
-
-	if len(e.CustomerIP) > 0 {
-		values.Add("evidence[customer_purchase_ip]", e.CustomerIP)
-	}
-
-	if len(e.CustomerSig) > 0 {
-		values.Add("evidence[customer_signature]", e.CustomerSig)
-	}
-
-	if len(e.BillingAddress) > 0 {
-		values.Add("evidence[billing_address]", e.BillingAddress)
-	}
-
-	if len(e.Receipt) > 0 {
-		values.Add("evidence[receipt]", e.Receipt)
-	}
-
-	if len(e.ShippingAddress) > 0 {
-		values.Add("evidence[shipping_address]", e.ShippingAddress)
-	}
-
-	if len(e.ShippingDate) > 0 {
-		values.Add("evidence[shipping_date]", e.ShippingDate)
-	}
-
-	if len(e.ShippingTracking) > 0 {
-		values.Add("evidence[shipping_tracking_number]", e.ShippingTracking)
-	}
-
-	if len(e.ShippingDoc) > 0 {
-		values.Add("evidence[shipping_documentation]", e.ShippingDoc)
-	}
-
-	if len(e.RefundPolicy) > 0 {
-		values.Add("evidence[refund_policy]", e.RefundPolicy)
-	}
-
-	if len(e.RefundPolicyDisclosure) > 0 {
-		values.Add("evidence[refund_policy_disclosure]", e.RefundPolicyDisclosure)
-	}
-
-	if len(e.RefundRefusalReason) > 0 {
-		values.Add("evidence[refund_refusal_explanation]", e.RefundRefusalReason)
-	}
-
-	if len(e.CancellationPolicy) > 0 {
-		values.Add("evidence[cancellation_policy]", e.CancellationPolicy)
-	}
-
-	if len(e.CancellationPolicyDisclsoure) > 0 {
-		values.Add("evidence[cancellation_policy_disclosure]", e.CancellationPolicyDisclsoure)
-	}
-
-	if len(e.CancellationRebuttal) > 0 {
-		values.Add("evidence[cancellation_rebuttal]", e.CancellationRebuttal)
-	}
-
-	if len(e.ActivityLog) > 0 {
-		values.Add("evidence[access_activity_log]", e.ActivityLog)
-	}
-
-	if len(e.ServiceDate) > 0 {
-		values.Add("evidence[service_date]", e.ServiceDate)
-	}
-
-	if len(e.ServiceDoc) > 0 {
-		values.Add("evidence[service_documentation]", e.ServiceDoc)
-	}
-
-	if len(e.DuplicateCharge) > 0 {
-		values.Add("evidence[duplicate_charge_id]", e.DuplicateCharge)
-	}
-
-	if len(e.DuplicateChargeReason) > 0 {
-		values.Add("evidence[duplicate_charge_explanation]", e.DuplicateChargeReason)
-	}
-
-	if len(e.DuplicateChargeDoc) > 0 {
-		values.Add("evidence[duplicate_charge_documentation]", e.DuplicateChargeDoc)
-	}
-
-	if len(e.CustomerComm) > 0 {
-		values.Add("evidence[customer_communication]", e.CustomerComm)
-	}
-
-	if len(e.UncategorizedText) > 0 {
-		values.Add("evidence[uncategorized_text]", e.UncategorizedText)
-	}
-
-	if len(e.UncategorizedFile) > 0 {
-		values.Add("evidence[uncategorized_file]", e.UncategorizedFile)
-	}
+	Extra
+	ID       string    `json:"id"`
+	Created  Timestamp `json:"created"`
+	Size     int       `json:"size"`
+	Purpose  string    `json:"purpose"`
+	URL      string    `json:"url"`
+	Mime     string    `json:"mime_type"`
+	Expanded bool      `json:"-"`
 }
 
 // UnmarshalJSON handles deserialization of a File.
@@ -216,14 +131,5 @@ func (e *DisputeEvidenceParams) AppendDetails(values *url.Values) {
 // property may be an id or the full struct if it was expanded.
 func (f *File) UnmarshalJSON(data []byte) error {
 	type file File
-	var ff file
-	err := json.Unmarshal(data, &ff)
-	if err == nil {
-		*f = File(ff)
-	} else {
-		// the id is surrounded by "\" characters, so strip them
-		f.ID = string(data[1 : len(data)-1])
-	}
-
-	return nil
+	return unmarshalExpandable(data, &f.ID, &f.Expanded, (*file)(f))
 }
@@ -6,6 +6,7 @@ import (
 	"net/url"
 
 	stripe "github.com/channelmeter/stripe-go"
+	"github.com/channelmeter/stripe-go/form"
 )
 
 const (
@@ -32,6 +33,9 @@ const (
 type Client struct {
 	B   stripe.Backend
 	Key string
+	// NoValidate disables the client-side validation of params
+	// that is otherwise done before calling the backend.
+	NoValidate bool
 }
 
 // Update updates a charge's dispute.
@@ -41,17 +45,17 @@ func Update(id string, params *stripe.DisputeParams) (*stripe.Dispute, error) {
 }
 
 func (c Client) Update(id string, params *stripe.DisputeParams) (*stripe.Dispute, error) {
+	if err := stripe.ValidateParams(params, c.NoValidate); err != nil {
+		return nil, err
+	}
+
 	var body *url.Values
 	var commonParams *stripe.Params
 
 	if params != nil {
 		commonParams = &params.Params
 		body = &url.Values{}
-
-		if params.Evidence != nil {
-			params.Evidence.AppendDetails(body)
-		}
-		params.AppendTo(body)
+		form.AppendTo(body, params)
 	}
 
 	dispute := &stripe.Dispute{}
@@ -74,5 +78,5 @@ func (c Client) Close(id string) (*stripe.Dispute, error) {
 }
 
 func getC() Client {
-	return Client{stripe.GetBackend(stripe.APIBackend), stripe.Key}
+	return Client{B: stripe.GetBackend(stripe.APIBackend), Key: stripe.Key}
 }
@@ -9,7 +9,8 @@ type ErrorType string
 // ErrorCode is the list of allowed values for the error's code.
 // Allowed values are "incorrect_number", "invalid_number", "invalid_expiry_month",
 // "invalid_expiry_year", "invalid_cvc", "expired_card", "incorrect_cvc", "incorrect_zip",
-// "card_declined", "missing", "processing_error", "rate_limit".
+// "card_declined", "missing", "processing_error", "rate_limit", "amount_too_small",
+// "invalid_amount".
 type ErrorCode string
 
 const (
@@ -17,18 +18,20 @@ const (
 	APIErr         ErrorType = "api_error"
 	CardErr        ErrorType = "card_error"
 
-	IncorrectNum  ErrorCode = "incorrect_number"
-	InvalidNum    ErrorCode = "invalid_number"
-	InvalidExpM   ErrorCode = "invalid_expiry_month"
-	InvalidExpY   ErrorCode = "invalid_expiry_year"
-	InvalidCvc    ErrorCode = "invalid_cvc"
-	ExpiredCard   ErrorCode = "expired_card"
-	IncorrectCvc  ErrorCode = "incorrect_cvc"
-	IncorrectZip  ErrorCode = "incorrect_zip"
-	CardDeclined  ErrorCode = "card_declined"
-	Missing       ErrorCode = "missing"
-	ProcessingErr ErrorCode = "processing_error"
-	RateLimit     ErrorCode = "rate_limit"
+	IncorrectNum   ErrorCode = "incorrect_number"
+	InvalidNum     ErrorCode = "invalid_number"
+	InvalidExpM    ErrorCode = "invalid_expiry_month"
+	InvalidExpY    ErrorCode = "invalid_expiry_year"
+	InvalidCvc     ErrorCode = "invalid_cvc"
+	ExpiredCard    ErrorCode = "expired_card"
+	IncorrectCvc   ErrorCode = "incorrect_cvc"
+	IncorrectZip   ErrorCode = "incorrect_zip"
+	CardDeclined   ErrorCode = "card_declined"
+	Missing        ErrorCode = "missing"
+	ProcessingErr  ErrorCode = "processing_error"
+	RateLimit      ErrorCode = "rate_limit"
+	AmountTooSmall ErrorCode = "amount_too_small"
+	InvalidAmount  ErrorCode = "invalid_amount"
 )
 
 // Error is the response returned when a call is unsuccessful.
@@ -8,9 +8,10 @@ import (
 // Event is the resource representing a Stripe event.
 // For more details see https://stripe.com/docs/api#events.
 type Event struct {
+	Extra
 	ID       string     `json:"id"`
 	Live     bool       `json:"livemode"`
-	Created  int64      `json:"created"`
+	Created  Timestamp  `json:"created"`
 	Data     *EventData `json:"data"`
 	Webhooks uint64     `json:"pending_webhooks"`
 	Type     string     `json:"type"`
@@ -18,6 +19,12 @@ type Event struct {
 	UserID   string     `json:"user_id"`
 }
 
+// UnmarshalJSON handles deserialization of an Event.
+func (e *Event) UnmarshalJSON(data []byte) error {
+	type event Event
+	return unmarshalResource(data, (*event)(e))
+}
+
 // EventData is the unmarshalled object as a map.
 type EventData struct {
 	Raw  json.RawMessage        `json:"object"`
@@ -29,9 +36,18 @@ type EventData struct {
 // For more details see https://stripe.com/docs/api#list_events.
 type EventListParams struct {
 	ListParams
-	Created int64
+	Created      Timestamp    `form:"created"`
+	CreatedRange *RangeParams `form:"created"`
 	// Type is one of the values documented at https://stripe.com/docs/api#event_types.
-	Type string
+	Type string `form:"type"`
+}
+
+// Validate checks the event list parameters before they are sent.
+func (p *EventListParams) Validate() error {
+	v := &validation{}
+	p.ListParams.validate(v)
+	v.timeRange("created", p.Created, p.CreatedRange)
+	return v.err()
 }
 
 // GetObjValue returns the value from the e.Data.Obj bag based on the keys hierarchy.
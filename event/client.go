@@ -3,15 +3,18 @@ package event
 
 import (
 	"net/url"
-	"strconv"
 
 	stripe "github.com/channelmeter/stripe-go"
+	"github.com/channelmeter/stripe-go/form"
 )
 
 // Client is used to invoke /events APIs.
 type Client struct {
 	B   stripe.Backend
 	Key string
+	// NoValidate disables the client-side validation of params
+	// that is otherwise done before calling the backend.
+	NoValidate bool
 }
 
 // Get returns the details of an event
@@ -34,6 +37,10 @@ func List(params *stripe.EventListParams) *Iter {
 }
 
 func (c Client) List(params *stripe.EventListParams) *Iter {
+	if err := stripe.ValidateParams(params, c.NoValidate); err != nil {
+		return &Iter{stripe.GetIterErr(err)}
+	}
+
 	type eventList struct {
 		stripe.ListMeta
 		Values []*stripe.Event `json:"data"`
@@ -44,16 +51,7 @@ func (c Client) List(params *stripe.EventListParams) *Iter {
 
 	if params != nil {
 		body = &url.Values{}
-
-		if params.Created > 0 {
-			body.Add("created", strconv.FormatInt(params.Created, 10))
-		}
-
-		if len(params.Type) > 0 {
-			body.Add("type", params.Type)
-		}
-
-		params.AppendTo(body)
+		form.AppendTo(body, params)
 		lp = &params.ListParams
 	}
 
@@ -84,5 +82,5 @@ func (i *Iter) Event() *stripe.Event {
 }
 
 func getC() Client {
-	return Client{stripe.GetBackend(stripe.APIBackend), stripe.Key}
+	return Client{B: stripe.GetBackend(stripe.APIBackend), Key: stripe.Key}
 }
@@ -1,25 +1,33 @@
 package stripe
 
-import "encoding/json"
-
 // FeeParams is the set of parameters that can be used when refunding an application fee.
 // For more details see https://stripe.com/docs/api#refund_application_fee.
 type FeeParams struct {
 	Params
-	Amount uint64
+	Amount uint64 `form:"amount"`
 }
 
 // FeeListParams is the set of parameters that can be used when listing application fees.
 // For more details see https://stripe.com/docs/api#list_application_fees.
 type FeeListParams struct {
 	ListParams
-	Created int64
-	Charge  string
+	Created      Timestamp    `form:"created"`
+	CreatedRange *RangeParams `form:"created"`
+	Charge       string       `form:"charge"`
+}
+
+// Validate checks the application fee list parameters before they are sent.
+func (p *FeeListParams) Validate() error {
+	v := &validation{}
+	p.ListParams.validate(v)
+	v.timeRange("created", p.Created, p.CreatedRange)
+	return v.err()
 }
 
 // Fee is the resource representing a Stripe application fee.
 // For more details see https://stripe.com/docs/api#application_fees.
 type Fee struct {
+	Extra
 	ID             string         `json:"id"`
 	Live           bool           `json:"livemode"`
 	Account        *Account       `json:"account"`
@@ -27,11 +35,12 @@ type Fee struct {
 	App            string         `json:"application"`
 	Tx             *Transaction   `json:"balance_transaction"`
 	Charge         *Charge        `json:"charge"`
-	Created        int64          `json:"created"`
+	Created        Timestamp      `json:"created"`
 	Currency       Currency       `json:"currency"`
 	Refunded       bool           `json:"refunded"`
 	Refunds        *FeeRefundList `json:"refunds"`
 	AmountRefunded uint64         `json:"amount_refunded"`
+	Expanded       bool           `json:"-"`
 }
 
 // UnmarshalJSON handles deserialization of a Fee.
@@ -39,14 +48,5 @@ type Fee struct {
 // property may be an id or the full struct if it was expanded.
 func (f *Fee) UnmarshalJSON(data []byte) error {
 	type appfee Fee
-	var ff appfee
-	err := json.Unmarshal(data, &ff)
-	if err == nil {
-		*f = Fee(ff)
-	} else {
-		// the id is surrounded by "\" characters, so strip them
-		f.ID = string(data[1 : len(data)-1])
-	}
-
-	return nil
+	return unmarshalExpandable(data, &f.ID, &f.Expanded, (*appfee)(f))
 }
@@ -4,15 +4,18 @@ package fee
 import (
 	"fmt"
 	"net/url"
-	"strconv"
 
 	stripe "github.com/channelmeter/stripe-go"
+	"github.com/channelmeter/stripe-go/form"
 )
 
 // Client is used to invoke application_fees APIs.
 type Client struct {
 	B   stripe.Backend
 	Key string
+	// NoValidate disables the client-side validation of params
+	// that is otherwise done before calling the backend.
+	NoValidate bool
 }
 
 // Get returns the details of an application fee.
@@ -44,6 +47,10 @@ func List(params *stripe.FeeListParams) *Iter {
 }
 
 func (c Client) List(params *stripe.FeeListParams) *Iter {
+	if err := stripe.ValidateParams(params, c.NoValidate); err != nil {
+		return &Iter{stripe.GetIterErr(err)}
+	}
+
 	type feeList struct {
 		stripe.ListMeta
 		Values []*stripe.Fee `json:"data"`
@@ -54,16 +61,7 @@ func (c Client) List(params *stripe.FeeListParams) *Iter {
 
 	if params != nil {
 		body = &url.Values{}
-
-		if params.Created > 0 {
-			body.Add("created", strconv.FormatInt(params.Created, 10))
-		}
-
-		if len(params.Charge) > 0 {
-			body.Add("charge", params.Charge)
-		}
-
-		params.AppendTo(body)
+		form.AppendTo(body, params)
 		lp = &params.ListParams
 	}
 
@@ -94,5 +92,5 @@ func (i *Iter) Fee() *stripe.Fee {
 }
 
 func getC() Client {
-	return Client{stripe.GetBackend(stripe.APIBackend), stripe.Key}
+	return Client{B: stripe.GetBackend(stripe.APIBackend), Key: stripe.Key}
 }
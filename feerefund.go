@@ -1,14 +1,20 @@
 package stripe
 
-import "encoding/json"
-
 // FeeRefundParams is the set of parameters that can be used when refunding a fee.
 // For more details see https://stripe.com/docs/api#fee_refund.
 type FeeRefundParams struct {
 	Params
-	Fee    string
-	Amount uint64
-	Meta   map[string]string
+	Fee    string            `form:"-"`
+	Amount uint64            `form:"amount"`
+	Meta   map[string]string `form:"metadata"`
+}
+
+// Validate checks the application fee refund parameters before they are sent.
+func (p *FeeRefundParams) Validate() error {
+	v := &validation{}
+	p.Params.validate(v)
+	v.required("fee", p.Fee)
+	return v.err()
 }
 
 // FeeRefundListParams is the set of parameters that can be used when listing fee refunds.
@@ -18,16 +24,26 @@ type FeeRefundListParams struct {
 	Fee string
 }
 
+// Validate checks the application fee refund list parameters before they are sent.
+func (p *FeeRefundListParams) Validate() error {
+	v := &validation{}
+	p.ListParams.validate(v)
+	v.required("fee", p.Fee)
+	return v.err()
+}
+
 // FeeRefund is the resource representing a Stripe fee refund.
 // For more details see https://stripe.com/docs/api#fee_refunds.
 type FeeRefund struct {
+	Extra
 	ID       string            `json:"id"`
 	Amount   uint64            `json:"amount"`
-	Created  int64             `json:"created"`
+	Created  Timestamp         `json:"created"`
 	Currency Currency          `json:"currency"`
 	Tx       *Transaction      `json:"balance_transaction"`
 	Fee      string            `json:"fee"`
 	Meta     map[string]string `json:"metadata"`
+	Expanded bool              `json:"-"`
 }
 
 // FeeRefundList is a list object for fee refunds.
@@ -41,14 +57,5 @@ type FeeRefundList struct {
 // property may be an id or the full struct if it was expanded.
 func (f *FeeRefund) UnmarshalJSON(data []byte) error {
 	type feerefund FeeRefund
-	var ff feerefund
-	err := json.Unmarshal(data, &ff)
-	if err == nil {
-		*f = FeeRefund(ff)
-	} else {
-		// the id is surrounded by "\" characters, so strip them
-		f.ID = string(data[1 : len(data)-1])
-	}
-
-	return nil
+	return unmarshalExpandable(data, &f.ID, &f.Expanded, (*feerefund)(f))
 }
@@ -4,15 +4,18 @@ package feerefund
 import (
 	"fmt"
 	"net/url"
-	"strconv"
 
 	stripe "github.com/channelmeter/stripe-go"
+	"github.com/channelmeter/stripe-go/form"
 )
 
 // Client is used to invoke /application_fees/refunds APIs.
 type Client struct {
 	B   stripe.Backend
 	Key string
+	// NoValidate disables the client-side validation of params
+	// that is otherwise done before calling the backend.
+	NoValidate bool
 }
 
 // New refunds the application fee collected.
@@ -22,13 +25,12 @@ func New(params *stripe.FeeRefundParams) (*stripe.FeeRefund, error) {
 }
 
 func (c Client) New(params *stripe.FeeRefundParams) (*stripe.FeeRefund, error) {
-	body := &url.Values{}
-
-	if params.Amount > 0 {
-		body.Add("amount", strconv.FormatUint(params.Amount, 10))
+	if err := stripe.ValidateParams(params, c.NoValidate); err != nil {
+		return nil, err
 	}
 
-	params.AppendTo(body)
+	body := &url.Values{}
+	form.AppendTo(body, params)
 
 	refund := &stripe.FeeRefund{}
 	err := c.B.Call("POST", fmt.Sprintf("application_fees/%v/refunds", params.Fee), c.Key, body, &params.Params, refund)
@@ -63,8 +65,12 @@ func Update(id string, params *stripe.FeeRefundParams) (*stripe.FeeRefund, error
 }
 
 func (c Client) Update(id string, params *stripe.FeeRefundParams) (*stripe.FeeRefund, error) {
+	if err := stripe.ValidateParams(params, c.NoValidate); err != nil {
+		return nil, err
+	}
+
 	body := &url.Values{}
-	params.AppendTo(body)
+	form.AppendTo(body, params)
 
 	refund := &stripe.FeeRefund{}
 	err := c.B.Call("POST", fmt.Sprintf("/application_fees/%v/refunds/%v", params.Fee, id), c.Key, body, &params.Params, refund)
@@ -79,15 +85,44 @@ func List(params *stripe.FeeRefundListParams) *Iter {
 }
 
 func (c Client) List(params *stripe.FeeRefundListParams) *Iter {
+	if err := stripe.ValidateParams(params, c.NoValidate); err != nil {
+		return &Iter{stripe.GetIterErr(err)}
+	}
+
 	body := &url.Values{}
 	var lp *stripe.ListParams
 
-	params.AppendTo(body)
+	form.AppendTo(body, params)
 	lp = &params.ListParams
 
-	return &Iter{stripe.GetIter(lp, body, func(b url.Values) ([]interface{}, stripe.ListMeta, error) {
+	return &Iter{stripe.GetIter(lp, body, c.query(params.Fee))}
+}
+
+// ListFrom returns all the refunds of an application fee, starting with the
+// ones embedded in it and fetching the remaining pages as needed.
+func ListFrom(fee *stripe.Fee) *Iter {
+	return getC().ListFrom(fee)
+}
+
+func (c Client) ListFrom(fee *stripe.Fee) *Iter {
+	list := fee.Refunds
+	if list == nil {
+		list = &stripe.FeeRefundList{ListMeta: stripe.ListMeta{More: true}}
+	}
+
+	values := make([]interface{}, len(list.Values))
+	for i, v := range list.Values {
+		values[i] = v
+	}
+
+	return &Iter{stripe.GetIterFrom(nil, nil, values, list.ListMeta, c.query(fee.ID))}
+}
+
+// query returns the query fetching a page of refunds of an application fee.
+func (c Client) query(feeID string) stripe.Query {
+	return func(b url.Values) ([]interface{}, stripe.ListMeta, error) {
 		list := &stripe.FeeRefundList{}
-		err := c.B.Call("GET", fmt.Sprintf("/application_fees/%v/refunds", params.Fee), c.Key, &b, nil, list)
+		err := c.B.Call("GET", fmt.Sprintf("/application_fees/%v/refunds", feeID), c.Key, &b, nil, list)
 
 		ret := make([]interface{}, len(list.Values))
 		for i, v := range list.Values {
@@ -95,7 +130,7 @@ func (c Client) List(params *stripe.FeeRefundListParams) *Iter {
 		}
 
 		return ret, list.ListMeta, err
-	})}
+	}
 }
 
 // Iter is an iterator for lists of FeeRefunds.
@@ -112,5 +147,5 @@ func (i *Iter) FeeRefund() *stripe.FeeRefund {
 }
 
 func getC() Client {
-	return Client{stripe.GetBackend(stripe.APIBackend), stripe.Key}
+	return Client{B: stripe.GetBackend(stripe.APIBackend), Key: stripe.Key}
 }
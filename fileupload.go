@@ -1,7 +1,6 @@
 package stripe
 
 import (
-	"encoding/json"
 	"io"
 	"mime/multipart"
 	"os"
@@ -17,11 +16,36 @@ type FileUploadParams struct {
 	File    *os.File
 }
 
+// Validate checks that a file and its purpose are set.
+func (p *FileUploadParams) Validate() error {
+	v := &validation{}
+	p.Params.validate(v)
+	v.required("purpose", string(p.Purpose))
+	v.oneOf("purpose", string(p.Purpose), "dispute_evidence", "identity_document")
+
+	if p.File == nil {
+		v.add("file", "is required")
+	}
+
+	return v.err()
+}
+
 // FileUploadListParams is the set of parameters that can be used when listing
 // file uploads. For more details see https://stripe.com/docs/api#list_file_uploads.
 type FileUploadListParams struct {
-	Purpose FileUploadPurpose
+	Purpose FileUploadPurpose `form:"purpose"`
 	ListParams
+	Created      Timestamp    `form:"created"`
+	CreatedRange *RangeParams `form:"created"`
+}
+
+// Validate checks the file upload list parameters before they are sent.
+func (p *FileUploadListParams) Validate() error {
+	v := &validation{}
+	p.ListParams.validate(v)
+	v.oneOf("purpose", string(p.Purpose), "dispute_evidence", "identity_document")
+	v.timeRange("created", p.Created, p.CreatedRange)
+	return v.err()
 }
 
 // FileUploadPurpose is the purpose of a particular file upload. Allowed values
@@ -31,12 +55,14 @@ type FileUploadPurpose string
 // FileUpload is the resource representing a Stripe file upload.
 // For more details see https://stripe.com/docs/api#file_uploads.
 type FileUpload struct {
-	ID      string            `json:"id"`
-	Created int64             `json:"created"`
-	Size    int64             `json:"size"`
-	Purpose FileUploadPurpose `json:"purpose"`
-	URL     string            `json:"url"`
-	Type    string            `json:"type"`
+	Extra
+	ID       string            `json:"id"`
+	Created  Timestamp         `json:"created"`
+	Size     int64             `json:"size"`
+	Purpose  FileUploadPurpose `json:"purpose"`
+	URL      string            `json:"url"`
+	Type     string            `json:"type"`
+	Expanded bool              `json:"-"`
 }
 
 // AppendDetails adds the file upload details to an io.ReadWriter. It returns
@@ -78,14 +104,5 @@ func (f *FileUploadParams) AppendDetails(body io.ReadWriter) (string, error) {
 // property may be an id or the full struct if it was expanded.
 func (f *FileUpload) UnmarshalJSON(data []byte) error {
 	type file FileUpload
-	var ff file
-	err := json.Unmarshal(data, &ff)
-	if err == nil {
-		*f = FileUpload(ff)
-	} else {
-		// the id is surrounded by "\" characters, so strip them
-		f.ID = string(data[1 : len(data)-1])
-	}
-
-	return nil
+	return unmarshalExpandable(data, &f.ID, &f.Expanded, (*file)(f))
 }
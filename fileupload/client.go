@@ -7,6 +7,7 @@ import (
 	"net/url"
 
 	stripe "github.com/channelmeter/stripe-go"
+	"github.com/channelmeter/stripe-go/form"
 )
 
 const (
@@ -18,6 +19,9 @@ const (
 type Client struct {
 	B   stripe.Backend
 	Key string
+	// NoValidate disables the client-side validation of params
+	// that is otherwise done before calling the backend.
+	NoValidate bool
 }
 
 // New POSTs new file uploads.
@@ -27,6 +31,10 @@ func New(params *stripe.FileUploadParams) (*stripe.FileUpload, error) {
 }
 
 func (c Client) New(params *stripe.FileUploadParams) (*stripe.FileUpload, error) {
+	if err := stripe.ValidateParams(params, c.NoValidate); err != nil {
+		return nil, err
+	}
+
 	if params == nil {
 		return nil, fmt.Errorf("params cannot be nil, and params.Purpose and params.File must be set")
 	}
@@ -74,6 +82,10 @@ func List(params *stripe.FileUploadListParams) *Iter {
 }
 
 func (c Client) List(params *stripe.FileUploadListParams) *Iter {
+	if err := stripe.ValidateParams(params, c.NoValidate); err != nil {
+		return &Iter{stripe.GetIterErr(err)}
+	}
+
 	type fileUploadList struct {
 		stripe.ListMeta
 		Values []*stripe.FileUpload `json:"data"`
@@ -84,12 +96,7 @@ func (c Client) List(params *stripe.FileUploadListParams) *Iter {
 
 	if params != nil {
 		body = &url.Values{}
-
-		if len(params.Purpose) > 0 {
-			body.Add("purpose", string(params.Purpose))
-		}
-
-		params.AppendTo(body)
+		form.AppendTo(body, params)
 		lp = &params.ListParams
 	}
 
@@ -119,5 +126,5 @@ func (i *Iter) FileUpload() *stripe.FileUpload {
 }
 
 func getC() Client {
-	return Client{stripe.GetBackend(stripe.UploadsBackend), stripe.Key}
+	return Client{B: stripe.GetBackend(stripe.UploadsBackend), Key: stripe.Key}
 }
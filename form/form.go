@@ -0,0 +1,380 @@
+// Package form encodes params structures into the url.Values sent to the
+// Stripe API.
+//
+// The encoding of a field is described by its `form` struct tag:
+//
+//	Amount    uint64            `form:"amount"`
+//	NoCapture bool              `form:"capture,invert"`
+//	Meta      map[string]string `form:"metadata"`
+//
+// Nested structures and maps are encoded as name[key], slices of scalars as
+// name[] and slices of structures as name[0][key]. Embedded structures
+// without a tag are flattened into their parent. Zero values are skipped,
+// unless the field is tagged with zero or a Zeroer asks for it. An empty
+// slice sent that way is encoded as name= to clear the list.
+//
+// The tag options are:
+//
+//	zero     encode the field even when it holds its zero value
+//	invert   encode a bool as its opposite, so that only a true field is sent
+//	indexed  encode a slice of scalars as name[0], name[1], ...
+//	inline   encode the field in place of its parent, like an embedded one
+//
+// Fields tagged with "-" and fields without a tag are never encoded. Types
+// that need a special encoding can implement Appender.
+package form
+
+import (
+	"bytes"
+	"encoding"
+	"net/url"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Appender is implemented by types that encode themselves. The values are
+// added under the key made of keyParts, which is empty at the top level.
+// As with any method, an Appender embedded in a struct is promoted to it,
+// so that the struct is entirely encoded by the embedded type.
+type Appender interface {
+	AppendForm(values *url.Values, keyParts []string)
+}
+
+// Zeroer is implemented by params that send some of their fields even when
+// they hold their zero value, which is how a field is set to zero or an
+// optional string is cleared. The key of the field is relative to the
+// Zeroer, such as quantity or legal_entity[dob][day].
+type Zeroer interface {
+	SendZero(key string) bool
+}
+
+// encoder holds the state of a single encoding.
+type encoder struct {
+	values *url.Values
+
+	// zeroer is the closest Zeroer enclosing the current value and base
+	// the number of key parts leading to it.
+	zeroer Zeroer
+	base   int
+}
+
+// field is the encoding information of a single struct field.
+type field struct {
+	index   []int
+	name    string
+	inline  bool
+	zero    bool
+	invert  bool
+	indexed bool
+}
+
+var (
+	appenderType = reflect.TypeOf((*Appender)(nil)).Elem()
+	zeroerType   = reflect.TypeOf((*Zeroer)(nil)).Elem()
+	timeType     = reflect.TypeOf(time.Time{})
+
+	cacheMu sync.RWMutex
+	cache   = make(map[reflect.Type][]field)
+)
+
+// AppendTo adds the encoding of i to the values.
+func AppendTo(values *url.Values, i interface{}) {
+	AppendToPrefixed(values, i, nil)
+}
+
+// AppendToPrefixed adds the encoding of i to the values, nesting every key
+// under keyParts.
+func AppendToPrefixed(values *url.Values, i interface{}, keyParts []string) {
+	e := &encoder{values: values}
+	e.encode(reflect.ValueOf(i), keyParts, field{})
+}
+
+// AppendFields adds the tagged fields of the structure i to the values,
+// nesting every key under keyParts. Unlike AppendToPrefixed it does not use
+// the Appender of i itself, so an Appender can call it to fall back to the
+// default encoding.
+func AppendFields(values *url.Values, i interface{}, keyParts []string) {
+	v := reflect.Indirect(reflect.ValueOf(i))
+	if v.Kind() != reflect.Struct {
+		return
+	}
+
+	e := &encoder{values: values}
+	if z, ok := i.(Zeroer); ok {
+		e.zeroer, e.base = z, len(keyParts)
+	}
+	e.encodeStruct(v, keyParts)
+}
+
+// FormatKey joins keyParts into a form key such as
+// legal_entity[address][city]. An empty part produces the [] used by
+// slices.
+func FormatKey(keyParts []string) string {
+	if len(keyParts) == 0 {
+		return ""
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString(keyParts[0])
+
+	for _, part := range keyParts[1:] {
+		buf.WriteByte('[')
+		buf.WriteString(part)
+		buf.WriteByte(']')
+	}
+
+	return buf.String()
+}
+
+func (e *encoder) encode(v reflect.Value, keyParts []string, f field) {
+	if !v.IsValid() {
+		return
+	}
+
+	if (v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface) && v.IsNil() {
+		return
+	}
+
+	if a, ok := appender(v); ok {
+		a.AppendForm(e.values, keyParts)
+		return
+	}
+
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		e.encode(v.Elem(), keyParts, f)
+		return
+	}
+
+	if v.Type() == timeType && v.CanInterface() {
+		t := v.Interface().(time.Time)
+		if !t.IsZero() {
+			e.values.Add(FormatKey(keyParts), strconv.FormatInt(t.Unix(), 10))
+		}
+		return
+	}
+
+	if m, ok := textMarshaler(v); ok {
+		if v.Kind() == reflect.Slice && v.IsNil() {
+			return
+		}
+
+		if text, err := m.MarshalText(); err == nil && (len(text) > 0 || e.zero(keyParts, f)) {
+			e.values.Add(FormatKey(keyParts), string(text))
+		}
+		return
+	}
+
+	switch v.Kind() {
+	case reflect.Struct:
+		e.encodeStruct(v, keyParts)
+
+	case reflect.Map:
+		keys := make([]string, 0, v.Len())
+		for _, k := range v.MapKeys() {
+			keys = append(keys, k.String())
+		}
+		sort.Strings(keys)
+
+		for _, k := range keys {
+			e.encode(v.MapIndex(reflect.ValueOf(k).Convert(v.Type().Key())), appendKey(keyParts, k), field{zero: true})
+		}
+
+	case reflect.Slice, reflect.Array:
+		if v.Len() == 0 && e.zero(keyParts, f) {
+			e.values.Add(FormatKey(keyParts), "")
+		}
+
+		for i := 0; i < v.Len(); i++ {
+			elem := v.Index(i)
+			if f.indexed || !isScalar(elem.Type()) {
+				e.encode(elem, appendKey(keyParts, strconv.Itoa(i)), field{zero: true})
+			} else {
+				e.encode(elem, appendKey(keyParts, ""), field{zero: true})
+			}
+		}
+
+	case reflect.Bool:
+		b := v.Bool()
+		if f.invert {
+			if b || e.zero(keyParts, f) {
+				e.values.Add(FormatKey(keyParts), strconv.FormatBool(!b))
+			}
+		} else if b || e.zero(keyParts, f) {
+			e.values.Add(FormatKey(keyParts), strconv.FormatBool(b))
+		}
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if n := v.Int(); n != 0 || e.zero(keyParts, f) {
+			e.values.Add(FormatKey(keyParts), strconv.FormatInt(n, 10))
+		}
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		if n := v.Uint(); n != 0 || e.zero(keyParts, f) {
+			e.values.Add(FormatKey(keyParts), strconv.FormatUint(n, 10))
+		}
+
+	case reflect.Float32, reflect.Float64:
+		if n := v.Float(); n != 0 || e.zero(keyParts, f) {
+			e.values.Add(FormatKey(keyParts), strconv.FormatFloat(n, 'f', -1, 64))
+		}
+
+	case reflect.String:
+		if s := v.String(); len(s) > 0 || e.zero(keyParts, f) {
+			e.values.Add(FormatKey(keyParts), s)
+		}
+	}
+}
+
+func (e *encoder) encodeStruct(v reflect.Value, keyParts []string) {
+	if z, ok := zeroer(v); ok {
+		defer func(z Zeroer, base int) { e.zeroer, e.base = z, base }(e.zeroer, e.base)
+		e.zeroer, e.base = z, len(keyParts)
+	}
+
+	for _, f := range fields(v.Type()) {
+		fv := v.FieldByIndex(f.index)
+
+		if f.inline {
+			e.encode(fv, keyParts, f)
+		} else {
+			e.encode(fv, appendKey(keyParts, f.name), f)
+		}
+	}
+}
+
+// zero reports whether the field at keyParts is encoded even when it holds
+// its zero value.
+func (e *encoder) zero(keyParts []string, f field) bool {
+	if f.zero {
+		return true
+	}
+
+	if e.zeroer == nil || len(keyParts) <= e.base {
+		return false
+	}
+
+	return e.zeroer.SendZero(FormatKey(keyParts[e.base:]))
+}
+
+// appender returns the Appender implemented by v or, when v is addressable,
+// by a pointer to it.
+func appender(v reflect.Value) (Appender, bool) {
+	if !v.CanInterface() {
+		return nil, false
+	}
+
+	if v.Type().Implements(appenderType) {
+		return v.Interface().(Appender), true
+	}
+
+	if v.CanAddr() && v.Addr().Type().Implements(appenderType) {
+		return v.Addr().Interface().(Appender), true
+	}
+
+	return nil, false
+}
+
+// zeroer returns the Zeroer implemented by v or, when v is addressable, by a
+// pointer to it.
+func zeroer(v reflect.Value) (Zeroer, bool) {
+	if !v.CanInterface() {
+		return nil, false
+	}
+
+	if v.Type().Implements(zeroerType) {
+		return v.Interface().(Zeroer), true
+	}
+
+	if v.CanAddr() && v.Addr().Type().Implements(zeroerType) {
+		return v.Addr().Interface().(Zeroer), true
+	}
+
+	return nil, false
+}
+
+// textMarshaler returns the encoding.TextMarshaler implemented by v, which
+// is how values such as net.IP are encoded.
+func textMarshaler(v reflect.Value) (encoding.TextMarshaler, bool) {
+	if !v.CanInterface() {
+		return nil, false
+	}
+
+	m, ok := v.Interface().(encoding.TextMarshaler)
+	return m, ok
+}
+
+// fields returns the encoded fields of the struct type t.
+func fields(t reflect.Type) []field {
+	cacheMu.RLock()
+	fs, ok := cache[t]
+	cacheMu.RUnlock()
+
+	if ok {
+		return fs
+	}
+
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		tag := sf.Tag.Get("form")
+
+		if tag == "-" {
+			continue
+		}
+
+		if sf.Anonymous && len(tag) == 0 {
+			fs = append(fs, field{index: sf.Index, inline: true})
+			continue
+		}
+
+		if len(sf.PkgPath) > 0 || len(tag) == 0 {
+			continue
+		}
+
+		opts := strings.Split(tag, ",")
+		f := field{index: sf.Index, name: opts[0]}
+
+		for _, opt := range opts[1:] {
+			switch opt {
+			case "zero":
+				f.zero = true
+			case "invert":
+				f.invert = true
+			case "indexed":
+				f.indexed = true
+			case "inline":
+				f.inline = true
+			}
+		}
+
+		fs = append(fs, f)
+	}
+
+	cacheMu.Lock()
+	cache[t] = fs
+	cacheMu.Unlock()
+
+	return fs
+}
+
+func isScalar(t reflect.Type) bool {
+	switch t.Kind() {
+	case reflect.Struct, reflect.Map, reflect.Slice, reflect.Array, reflect.Ptr, reflect.Interface:
+		return t == timeType
+	}
+
+	return true
+}
+
+// appendKey returns a copy of keyParts with key added, so that sibling
+// fields never share a backing array.
+func appendKey(keyParts []string, key string) []string {
+	parts := make([]string, len(keyParts), len(keyParts)+1)
+	copy(parts, keyParts)
+
+	return append(parts, key)
+}
@@ -0,0 +1,207 @@
+package form
+
+import (
+	"net"
+	"net/url"
+	"reflect"
+	"testing"
+	"time"
+)
+
+type testAddress struct {
+	Line1 string `form:"line1"`
+	City  string `form:"city"`
+}
+
+type testOwner struct {
+	Name    string      `form:"name"`
+	Address testAddress `form:"address"`
+}
+
+type testCommon struct {
+	Meta map[string]string `form:"metadata"`
+	Exp  []string          `form:"expand"`
+}
+
+type testParams struct {
+	testCommon
+	Amount    uint64  `form:"amount"`
+	Percent   float64 `form:"percent"`
+	Balance   int64   `form:"balance,zero"`
+	Desc      string  `form:"description"`
+	Live      bool    `form:"live"`
+	NoCapture bool    `form:"capture,invert"`
+	Internal  string  `form:"-"`
+	Untagged  string
+	Address   *testAddress      `form:"address"`
+	Owners    []testOwner       `form:"owners"`
+	Days      []int             `form:"days,indexed"`
+	Date      time.Time         `form:"date"`
+	IP        net.IP            `form:"ip"`
+	Extra     map[string]string `form:"extra"`
+}
+
+type testAppender struct {
+	Value string
+}
+
+func (a *testAppender) AppendForm(values *url.Values, keyParts []string) {
+	values.Add(FormatKey(keyParts)+"[custom]", a.Value)
+}
+
+func TestFormatKey(t *testing.T) {
+	tests := map[string][]string{
+		"":                            nil,
+		"amount":                      {"amount"},
+		"legal_entity[address][city]": {"legal_entity", "address", "city"},
+		"expand[]":                    {"expand", ""},
+	}
+
+	for want, parts := range tests {
+		if got := FormatKey(parts); got != want {
+			t.Errorf("FormatKey(%q) = %q want %q", parts, got, want)
+		}
+	}
+}
+
+func TestAppendTo(t *testing.T) {
+	params := &testParams{
+		testCommon: testCommon{
+			Meta: map[string]string{"b": "2", "a": "1"},
+			Exp:  []string{"customer", "invoice"},
+		},
+		Amount:    1000,
+		Percent:   12.5,
+		NoCapture: true,
+		Internal:  "skipped",
+		Untagged:  "skipped",
+		Address:   &testAddress{City: "Paris"},
+		Owners: []testOwner{
+			{Name: "Jane", Address: testAddress{Line1: "1 Main St"}},
+			{Name: "John"},
+		},
+		Days: []int{1, 15},
+		Date: time.Unix(1424304000, 0),
+		IP:   net.ParseIP("127.0.0.1"),
+	}
+
+	values := &url.Values{}
+	AppendTo(values, params)
+
+	want := url.Values{
+		"metadata[a]":               {"1"},
+		"metadata[b]":               {"2"},
+		"expand[]":                  {"customer", "invoice"},
+		"amount":                    {"1000"},
+		"percent":                   {"12.5"},
+		"balance":                   {"0"},
+		"capture":                   {"false"},
+		"address[city]":             {"Paris"},
+		"owners[0][name]":           {"Jane"},
+		"owners[0][address][line1]": {"1 Main St"},
+		"owners[1][name]":           {"John"},
+		"days[0]":                   {"1"},
+		"days[1]":                   {"15"},
+		"date":                      {"1424304000"},
+		"ip":                        {"127.0.0.1"},
+	}
+
+	if !reflect.DeepEqual(*values, want) {
+		t.Errorf("values = %v\nwant %v", *values, want)
+	}
+}
+
+func TestAppendToSkipsZeroValues(t *testing.T) {
+	values := &url.Values{}
+	AppendTo(values, &testParams{})
+
+	want := url.Values{"balance": {"0"}}
+	if !reflect.DeepEqual(*values, want) {
+		t.Errorf("values = %v want %v", *values, want)
+	}
+}
+
+func TestAppendToPrefixed(t *testing.T) {
+	values := &url.Values{}
+	AppendToPrefixed(values, &testOwner{Name: "Jane", Address: testAddress{City: "Paris"}}, []string{"legal_entity", "owner"})
+
+	want := url.Values{
+		"legal_entity[owner][name]":          {"Jane"},
+		"legal_entity[owner][address][city]": {"Paris"},
+	}
+
+	if !reflect.DeepEqual(*values, want) {
+		t.Errorf("values = %v want %v", *values, want)
+	}
+}
+
+func TestAppendToUsesAppender(t *testing.T) {
+	params := &struct {
+		Nested *testAppender `form:"nested"`
+	}{&testAppender{Value: "x"}}
+
+	values := &url.Values{}
+	AppendTo(values, params)
+
+	if got := values.Get("nested[custom]"); got != "x" {
+		t.Errorf("nested[custom] = %q want x", got)
+	}
+}
+
+func TestAppendFieldsIgnoresOwnAppender(t *testing.T) {
+	type appended struct {
+		testAppender
+		Name string `form:"name"`
+	}
+
+	params := &appended{testAppender{Value: "x"}, "Jane"}
+
+	values := &url.Values{}
+	AppendTo(values, params)
+
+	want := url.Values{"[custom]": {"x"}}
+	if !reflect.DeepEqual(*values, want) {
+		t.Errorf("AppendTo values = %v want %v", *values, want)
+	}
+
+	values = &url.Values{}
+	AppendFields(values, params, []string{"owner"})
+
+	if got := values.Get("owner[name]"); got != "Jane" {
+		t.Errorf("owner[name] = %q want Jane", got)
+	}
+}
+
+type testZeroer struct {
+	Quantity uint64      `form:"quantity"`
+	Desc     string      `form:"description"`
+	Address  testAddress `form:"address"`
+	Tags     []string    `form:"tags"`
+	Codes    []string    `form:"codes"`
+	zero     map[string]bool
+}
+
+func (z *testZeroer) SendZero(key string) bool {
+	return z.zero[key]
+}
+
+func TestAppendToUsesZeroer(t *testing.T) {
+	params := &testZeroer{zero: map[string]bool{
+		"quantity":      true,
+		"address[city]": true,
+		"tags":          true,
+	}}
+
+	values := &url.Values{}
+	AppendToPrefixed(values, params, []string{"item"})
+
+	want := url.Values{
+		"item[quantity]":      {"0"},
+		"item[address][city]": {""},
+		"item[tags]":          {""},
+	}
+
+	if !reflect.DeepEqual(*values, want) {
+		t.Errorf("values = %v want %v", *values, want)
+	}
+}
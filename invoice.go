@@ -1,92 +1,199 @@
 package stripe
 
-import "encoding/json"
-
 // InvoiceLineType is the list of allowed values for the invoice line's type.
 // Allowed values are "invoiceitem", "subscription".
 type InvoiceLineType string
 
+// InvoiceStatus is the list of allowed values for the invoice's status.
+// Allowed values are "draft", "open", "paid", "uncollectible", "void".
+type InvoiceStatus string
+
 // InvoiceParams is the set of parameters that can be used when creating or updating an invoice.
 // For more details see https://stripe.com/docs/api#create_invoice, https://stripe.com/docs/api#update_invoice.
+// Closed and Forgive predate the invoice statuses; prefer the Finalize, Void
+// and MarkUncollectible operations of the invoice package. Likewise TaxPercent
+// predates tax rates; DefaultTaxRates applies to the lines without tax rates of their own.
 type InvoiceParams struct {
 	Params
-	Customer                string
-	Desc, Statement, Sub    string
-	Fee                     uint64
-	Closed, Forgive, Opened bool
-	TaxPercent              float64
+	Customer        string   `form:"customer"`
+	Desc            string   `form:"description"`
+	Statement       string   `form:"statement_descriptor"`
+	Sub             string   `form:"subscription"`
+	Fee             uint64   `form:"application_fee"`
+	AutoAdvance     bool     `form:"auto_advance"`
+	NoAutoAdvance   bool     `form:"auto_advance,invert"`
+	Closed          bool     `form:"closed"`
+	Forgive         bool     `form:"forgiven"`
+	Opened          bool     `form:"closed,invert"`
+	TaxPercent      float64  `form:"tax_percent"`
+	DefaultTaxRates []string `form:"default_tax_rates"`
+}
+
+// Validate checks the invoice parameters before they are sent.
+func (p *InvoiceParams) Validate() error {
+	v := &validation{}
+	p.Params.validate(v)
+	v.statement("statement_descriptor", p.Statement, maxStatementLen)
+	v.percent("tax_percent", p.TaxPercent)
+
+	if p.TaxPercent > 0 && len(p.DefaultTaxRates) > 0 {
+		v.add("default_tax_rates", "cannot be used together with tax_percent")
+	}
+
+	if p.Closed && p.Opened {
+		v.add("closed", "cannot close and reopen an invoice at the same time")
+	}
+
+	if p.AutoAdvance && p.NoAutoAdvance {
+		v.add("auto_advance", "cannot enable and disable automatic collection at the same time")
+	}
+
+	return v.err()
+}
+
+// InvoiceFinalizeParams is the set of parameters that can be used when finalizing a draft invoice.
+// For more details see https://stripe.com/docs/api#finalize_invoice.
+type InvoiceFinalizeParams struct {
+	Params
+	AutoAdvance   bool `form:"auto_advance"`
+	NoAutoAdvance bool `form:"auto_advance,invert"`
+}
+
+// Validate checks the invoice finalize parameters before they are sent.
+func (p *InvoiceFinalizeParams) Validate() error {
+	v := &validation{}
+	p.Params.validate(v)
+
+	if p.AutoAdvance && p.NoAutoAdvance {
+		v.add("auto_advance", "cannot enable and disable automatic collection at the same time")
+	}
+
+	return v.err()
 }
 
 // InvoiceListParams is the set of parameters that can be used when listing invoices.
 // For more details see https://stripe.com/docs/api#list_customer_invoices.
 type InvoiceListParams struct {
 	ListParams
-	Date     int64
-	Customer string
+	Date      Timestamp     `form:"date"`
+	DateRange *RangeParams  `form:"date"`
+	Customer  string        `form:"customer"`
+	Sub       string        `form:"subscription"`
+	Status    InvoiceStatus `form:"status"`
+}
+
+// Validate checks the invoice list parameters before they are sent.
+func (p *InvoiceListParams) Validate() error {
+	v := &validation{}
+	p.ListParams.validate(v)
+	v.timeRange("date", p.Date, p.DateRange)
+	v.oneOf("status", string(p.Status), "draft", "open", "paid", "uncollectible", "void")
+	return v.err()
 }
 
 // InvoiceLineListParams is the set of parameters that can be used when listing invoice line items.
 // For more details see https://stripe.com/docs/api#invoice_lines.
 type InvoiceLineListParams struct {
 	ListParams
-	ID            string
-	Customer, Sub string
+	ID       string `form:"-"`
+	Customer string `form:"customer"`
+	Sub      string `form:"subscription"`
+}
+
+// Validate checks the invoice line list parameters before they are sent.
+func (p *InvoiceLineListParams) Validate() error {
+	v := &validation{}
+	p.ListParams.validate(v)
+	v.required("id", p.ID)
+	return v.err()
 }
 
 // Invoice is the resource representing a Stripe invoice.
 // For more details see https://stripe.com/docs/api#invoice_object.
 type Invoice struct {
-	ID           string            `json:"id"`
-	Live         bool              `json:"livemode"`
-	Amount       int64             `json:"amount_due"`
-	Attempts     uint64            `json:"attempt_count"`
-	Attempted    bool              `json:"attempted"`
-	Closed       bool              `json:"closed"`
-	Currency     Currency          `json:"currency"`
-	Customer     *Customer         `json:"customer"`
-	Date         int64             `json:"date"`
-	Forgive      bool              `json:"forgiven"`
-	Lines        *InvoiceLineList  `json:"lines"`
-	Paid         bool              `json:"paid"`
-	End          int64             `json:"period_end"`
-	Start        int64             `json:"period_start"`
-	StartBalance int64             `json:"starting_balance"`
-	Subtotal     int64             `json:"subtotal"`
-	Total        int64             `json:"total"`
-	Tax          int64             `json:"tax"`
-	TaxPercent   float64           `json:"tax_percent"`
-	Fee          uint64            `json:"application_fee"`
-	Charge       *Charge           `json:"charge"`
-	Desc         string            `json:"description"`
-	Discount     *Discount         `json:"discount"`
-	EndBalance   int64             `json:"ending_balance"`
-	NextAttempt  int64             `json:"next_payment_attempt"`
-	Statement    string            `json:"statement_descriptor"`
-	Sub          string            `json:"subscription"`
-	Webhook      int64             `json:"webhooks_delivered_at"`
-	Meta         map[string]string `json:"metadata"`
+	Extra
+	ID              string              `json:"id"`
+	Live            bool                `json:"livemode"`
+	Amount          int64               `json:"amount_due"`
+	Attempts        uint64              `json:"attempt_count"`
+	Attempted       bool                `json:"attempted"`
+	AmountPaid      int64               `json:"amount_paid"`
+	AmountLeft      int64               `json:"amount_remaining"`
+	AutoAdvance     bool                `json:"auto_advance"`
+	Closed          bool                `json:"closed"`
+	Currency        Currency            `json:"currency"`
+	Customer        *Customer           `json:"customer"`
+	Date            Timestamp           `json:"date"`
+	Forgive         bool                `json:"forgiven"`
+	HostedURL       string              `json:"hosted_invoice_url"`
+	PDF             string              `json:"invoice_pdf"`
+	Lines           *InvoiceLineList    `json:"lines"`
+	Paid            bool                `json:"paid"`
+	End             Timestamp           `json:"period_end"`
+	Start           Timestamp           `json:"period_start"`
+	StartBalance    int64               `json:"starting_balance"`
+	Subtotal        int64               `json:"subtotal"`
+	Total           int64               `json:"total"`
+	Tax             int64               `json:"tax"`
+	TaxPercent      float64             `json:"tax_percent"`
+	DefaultTaxRates []*TaxRate          `json:"default_tax_rates"`
+	TaxAmounts      []*TaxAmount        `json:"total_tax_amounts"`
+	Fee             uint64              `json:"application_fee"`
+	Charge          *Charge             `json:"charge"`
+	Desc            string              `json:"description"`
+	Discount        *Discount           `json:"discount"`
+	PreCredit       int64               `json:"pre_payment_credit_notes_amount"`
+	PostCredit      int64               `json:"post_payment_credit_notes_amount"`
+	EndBalance      int64               `json:"ending_balance"`
+	NextAttempt     Timestamp           `json:"next_payment_attempt"`
+	Number          string              `json:"number"`
+	Statement       string              `json:"statement_descriptor"`
+	Status          InvoiceStatus       `json:"status"`
+	Transitions     *InvoiceTransitions `json:"status_transitions"`
+	Sub             string              `json:"subscription"`
+	Webhook         Timestamp           `json:"webhooks_delivered_at"`
+	Meta            map[string]string   `json:"metadata"`
+	Deleted         bool                `json:"deleted"`
+	Expanded        bool                `json:"-"`
+}
+
+// InvoiceTransitions records when an invoice moved between statuses.
+type InvoiceTransitions struct {
+	Finalized     Timestamp `json:"finalized_at"`
+	Uncollectible Timestamp `json:"marked_uncollectible_at"`
+	Paid          Timestamp `json:"paid_at"`
+	Voided        Timestamp `json:"voided_at"`
 }
 
 // InvoiceLine is the resource representing a Stripe invoice line item.
 // For more details see https://stripe.com/docs/api#invoice_line_item_object.
 type InvoiceLine struct {
-	ID        string            `json:"id"`
-	Live      bool              `json:"live_mode"`
-	Amount    int64             `json:"amount"`
-	Currency  Currency          `json:"currency"`
-	Period    *Period           `json:"period"`
-	Proration bool              `json:"proration"`
-	Type      InvoiceLineType   `json:"type"`
-	Desc      string            `json:"description"`
-	Meta      map[string]string `json:"metadata"`
-	Plan      *Plan             `json:"plan"`
-	Quantity  int64             `json:"quantity"`
+	Extra
+	ID         string            `json:"id"`
+	Live       bool              `json:"live_mode"`
+	Amount     int64             `json:"amount"`
+	Currency   Currency          `json:"currency"`
+	Period     *Period           `json:"period"`
+	Proration  bool              `json:"proration"`
+	Type       InvoiceLineType   `json:"type"`
+	Desc       string            `json:"description"`
+	Meta       map[string]string `json:"metadata"`
+	Plan       *Plan             `json:"plan"`
+	Quantity   int64             `json:"quantity"`
+	TaxRates   []*TaxRate        `json:"tax_rates"`
+	TaxAmounts []*TaxAmount      `json:"tax_amounts"`
+}
+
+// UnmarshalJSON handles deserialization of an InvoiceLine.
+func (i *InvoiceLine) UnmarshalJSON(data []byte) error {
+	type invoiceLine InvoiceLine
+	return unmarshalResource(data, (*invoiceLine)(i))
 }
 
 // Period is a structure representing a start and end dates.
 type Period struct {
-	Start int64 `json:"start"`
-	End   int64 `json:"end"`
+	Start Timestamp `json:"start"`
+	End   Timestamp `json:"end"`
 }
 
 // InvoiceLineList is a list object for invoice line items.
@@ -100,14 +207,5 @@ type InvoiceLineList struct {
 // property may be an id or the full struct if it was expanded.
 func (i *Invoice) UnmarshalJSON(data []byte) error {
 	type invoice Invoice
-	var ii invoice
-	err := json.Unmarshal(data, &ii)
-	if err == nil {
-		*i = Invoice(ii)
-	} else {
-		// the id is surrounded by "\" characters, so strip them
-		i.ID = string(data[1 : len(data)-1])
-	}
-
-	return nil
+	return unmarshalExpandable(data, &i.ID, &i.Expanded, (*invoice)(i))
 }
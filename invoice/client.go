@@ -2,22 +2,32 @@
 package invoice
 
 import (
+	"errors"
 	"fmt"
 	"net/url"
-	"strconv"
 
 	stripe "github.com/channelmeter/stripe-go"
+	"github.com/channelmeter/stripe-go/form"
 )
 
 const (
 	TypeInvoiceItem  stripe.InvoiceLineType = "invoiceitem"
 	TypeSubscription stripe.InvoiceLineType = "subscription"
+
+	DraftInvoice         stripe.InvoiceStatus = "draft"
+	OpenInvoice          stripe.InvoiceStatus = "open"
+	PaidInvoice          stripe.InvoiceStatus = "paid"
+	UncollectibleInvoice stripe.InvoiceStatus = "uncollectible"
+	VoidInvoice          stripe.InvoiceStatus = "void"
 )
 
 // Client is the client used to invoke /invoices APIs.
 type Client struct {
 	B   stripe.Backend
 	Key string
+	// NoValidate disables the client-side validation of params
+	// that is otherwise done before calling the backend.
+	NoValidate bool
 }
 
 // New POSTs new invoices.
@@ -27,32 +37,14 @@ func New(params *stripe.InvoiceParams) (*stripe.Invoice, error) {
 }
 
 func (c Client) New(params *stripe.InvoiceParams) (*stripe.Invoice, error) {
-	body := &url.Values{
-		"customer": {params.Customer},
-	}
-
-	if len(params.Desc) > 0 {
-		body.Add("description", params.Desc)
-	}
-
-	if len(params.Statement) > 0 {
-		body.Add("statement_descriptor", params.Statement)
-	}
-
-	if len(params.Sub) > 0 {
-		body.Add("subscription", params.Sub)
+	if err := stripe.ValidateParams(params, c.NoValidate); err != nil {
+		return nil, err
 	}
 
-	params.AppendTo(body)
+	body := &url.Values{}
+	form.AppendTo(body, params)
 
 	token := c.Key
-	if params.Fee > 0 {
-		body.Add("application_fee", strconv.FormatUint(params.Fee, 10))
-	}
-
-	if params.TaxPercent > 0 {
-		body.Add("tax_percent", strconv.FormatFloat(params.TaxPercent, 'f', 2, 64))
-	}
 
 	invoice := &stripe.Invoice{}
 	err := c.B.Call("POST", "/invoices", token, body, &params.Params, invoice)
@@ -104,56 +96,111 @@ func (c Client) Pay(id string, params *stripe.InvoiceParams) (*stripe.Invoice, e
 	return invoice, err
 }
 
-// Update updates an invoice's properties.
-// For more details see https://stripe.com/docs/api#update_invoice.
-func Update(id string, params *stripe.InvoiceParams) (*stripe.Invoice, error) {
-	return getC().Update(id, params)
+// Finalize finalizes a draft invoice so that it can be paid.
+// For more details see https://stripe.com/docs/api#finalize_invoice.
+func Finalize(id string, params *stripe.InvoiceFinalizeParams) (*stripe.Invoice, error) {
+	return getC().Finalize(id, params)
 }
 
-func (c Client) Update(id string, params *stripe.InvoiceParams) (*stripe.Invoice, error) {
+func (c Client) Finalize(id string, params *stripe.InvoiceFinalizeParams) (*stripe.Invoice, error) {
+	if err := stripe.ValidateParams(params, c.NoValidate); err != nil {
+		return nil, err
+	}
+
 	var body *url.Values
-	token := c.Key
 	var commonParams *stripe.Params
 
 	if params != nil {
 		commonParams = &params.Params
 		body = &url.Values{}
+		form.AppendTo(body, params)
+	}
 
-		if len(params.Desc) > 0 {
-			body.Add("description", params.Desc)
-		}
+	invoice := &stripe.Invoice{}
+	err := c.B.Call("POST", fmt.Sprintf("/invoices/%v/finalize", id), c.Key, body, commonParams, invoice)
 
-		if len(params.Statement) > 0 {
-			body.Add("statement_descriptor", params.Statement)
-		}
+	return invoice, err
+}
 
-		if len(params.Sub) > 0 {
-			body.Add("subscription", params.Sub)
-		}
+// Void voids a finalized invoice.
+// For more details see https://stripe.com/docs/api#void_invoice.
+func Void(id string, params *stripe.InvoiceParams) (*stripe.Invoice, error) {
+	return getC().Void(id, params)
+}
 
-		if params.Closed {
-			body.Add("closed", strconv.FormatBool(true))
-		}
+func (c Client) Void(id string, params *stripe.InvoiceParams) (*stripe.Invoice, error) {
+	return c.transition(id, "void", params)
+}
 
-		if params.Opened {
-			body.Add("closed", strconv.FormatBool(false))
-		}
+// Send sends a finalized invoice to the customer for manual payment.
+// For more details see https://stripe.com/docs/api#send_invoice.
+func Send(id string, params *stripe.InvoiceParams) (*stripe.Invoice, error) {
+	return getC().Send(id, params)
+}
 
-		if params.Forgive {
-			body.Add("forgiven", strconv.FormatBool(true))
-		}
+func (c Client) Send(id string, params *stripe.InvoiceParams) (*stripe.Invoice, error) {
+	return c.transition(id, "send", params)
+}
 
-		if params.Fee > 0 {
-			body.Add("application_fee", strconv.FormatUint(params.Fee, 10))
-		}
+// MarkUncollectible marks a finalized invoice as uncollectible.
+// For more details see https://stripe.com/docs/api#mark_uncollectible_invoice.
+func MarkUncollectible(id string, params *stripe.InvoiceParams) (*stripe.Invoice, error) {
+	return getC().MarkUncollectible(id, params)
+}
 
-		if params.TaxPercent > 0 {
-			body.Add("tax_percent", strconv.FormatFloat(params.TaxPercent, 'f', 2, 64))
-		}
+func (c Client) MarkUncollectible(id string, params *stripe.InvoiceParams) (*stripe.Invoice, error) {
+	return c.transition(id, "mark_uncollectible", params)
+}
 
+// transition POSTs to one of the invoice lifecycle actions that only take
+// the common parameters.
+func (c Client) transition(id, action string, params *stripe.InvoiceParams) (*stripe.Invoice, error) {
+	var body *url.Values
+	var commonParams *stripe.Params
+
+	if params != nil {
+		commonParams = &params.Params
+		body = &url.Values{}
 		params.AppendTo(body)
 	}
 
+	invoice := &stripe.Invoice{}
+	err := c.B.Call("POST", fmt.Sprintf("/invoices/%v/%v", id, action), c.Key, body, commonParams, invoice)
+
+	return invoice, err
+}
+
+// Del removes a draft invoice.
+// For more details see https://stripe.com/docs/api#delete_invoice.
+func Del(id string) error {
+	return getC().Del(id)
+}
+
+func (c Client) Del(id string) error {
+	return c.B.Call("DELETE", "/invoices/"+id, c.Key, nil, nil, nil)
+}
+
+// Update updates an invoice's properties.
+// For more details see https://stripe.com/docs/api#update_invoice.
+func Update(id string, params *stripe.InvoiceParams) (*stripe.Invoice, error) {
+	return getC().Update(id, params)
+}
+
+func (c Client) Update(id string, params *stripe.InvoiceParams) (*stripe.Invoice, error) {
+	if err := stripe.ValidateParams(params, c.NoValidate); err != nil {
+		return nil, err
+	}
+
+	var body *url.Values
+	token := c.Key
+	var commonParams *stripe.Params
+
+	if params != nil {
+		commonParams = &params.Params
+		body = &url.Values{}
+		form.AppendTo(body, params)
+	}
+
 	invoice := &stripe.Invoice{}
 	err := c.B.Call("POST", "/invoices/"+id, token, body, commonParams, invoice)
 
@@ -167,15 +214,8 @@ func GetNext(params *stripe.InvoiceParams) (*stripe.Invoice, error) {
 }
 
 func (c Client) GetNext(params *stripe.InvoiceParams) (*stripe.Invoice, error) {
-	body := &url.Values{
-		"customer": {params.Customer},
-	}
-
-	if len(params.Sub) > 0 {
-		body.Add("subscription", params.Sub)
-	}
-
-	params.AppendTo(body)
+	body := &url.Values{}
+	form.AppendTo(body, params)
 
 	invoice := &stripe.Invoice{}
 	err := c.B.Call("GET", "/invoices/upcoming", c.Key, body, &params.Params, invoice)
@@ -190,6 +230,10 @@ func List(params *stripe.InvoiceListParams) *Iter {
 }
 
 func (c Client) List(params *stripe.InvoiceListParams) *Iter {
+	if err := stripe.ValidateParams(params, c.NoValidate); err != nil {
+		return &Iter{stripe.GetIterErr(err)}
+	}
+
 	type invoiceList struct {
 		stripe.ListMeta
 		Values []*stripe.Invoice `json:"data"`
@@ -200,16 +244,7 @@ func (c Client) List(params *stripe.InvoiceListParams) *Iter {
 
 	if params != nil {
 		body = &url.Values{}
-
-		if len(params.Customer) > 0 {
-			body.Add("customer", params.Customer)
-		}
-
-		if params.Date > 0 {
-			body.Add("date", strconv.FormatInt(params.Date, 10))
-		}
-
-		params.AppendTo(body)
+		form.AppendTo(body, params)
 		lp = &params.ListParams
 	}
 
@@ -233,23 +268,61 @@ func ListLines(params *stripe.InvoiceLineListParams) *LineIter {
 }
 
 func (c Client) ListLines(params *stripe.InvoiceLineListParams) *LineIter {
+	if err := stripe.ValidateParams(params, c.NoValidate); err != nil {
+		return &LineIter{stripe.GetIterErr(err)}
+	}
+
 	body := &url.Values{}
 	var lp *stripe.ListParams
 
-	if len(params.Customer) > 0 {
-		body.Add("customer", params.Customer)
+	form.AppendTo(body, params)
+	lp = &params.ListParams
+
+	return &LineIter{stripe.GetIter(lp, body, c.query(params.ID))}
+}
+
+// ListLinesFrom returns all the line items of an invoice, starting with the ones
+// embedded in it and fetching the remaining pages as needed. The lines of an
+// upcoming invoice returned by GetNext are fetched for its customer and
+// subscription.
+func ListLinesFrom(inv *stripe.Invoice) *LineIter {
+	return getC().ListLinesFrom(inv)
+}
+
+func (c Client) ListLinesFrom(inv *stripe.Invoice) *LineIter {
+	list := inv.Lines
+	if list == nil {
+		list = &stripe.InvoiceLineList{ListMeta: stripe.ListMeta{More: true}}
 	}
 
-	if len(params.Sub) > 0 {
-		body.Add("subscription", params.Sub)
+	values := make([]interface{}, len(list.Values))
+	for i, v := range list.Values {
+		values[i] = v
 	}
 
-	params.AppendTo(body)
-	lp = &params.ListParams
+	if len(inv.ID) == 0 {
+		// an upcoming invoice has no ID, its lines are paged with its customer
+		if inv.Customer == nil {
+			return &LineIter{stripe.GetIterErr(errors.New("Invalid invoice: an upcoming invoice needs a customer to list its lines"))}
+		}
+
+		qs := &url.Values{}
+		qs.Add("customer", inv.Customer.ID)
+		if len(inv.Sub) > 0 {
+			qs.Add("subscription", inv.Sub)
+		}
 
-	return &LineIter{stripe.GetIter(lp, body, func(b url.Values) ([]interface{}, stripe.ListMeta, error) {
+		return &LineIter{stripe.GetIterFrom(nil, qs, values, list.ListMeta, c.query("upcoming"))}
+	}
+
+	return &LineIter{stripe.GetIterFrom(nil, nil, values, list.ListMeta, c.query(inv.ID))}
+}
+
+// query returns the query fetching a page of line items of an invoice.
+func (c Client) query(invoiceID string) stripe.Query {
+	return func(b url.Values) ([]interface{}, stripe.ListMeta, error) {
 		list := &stripe.InvoiceLineList{}
-		err := c.B.Call("GET", fmt.Sprintf("/invoices/%v/lines", params.ID), c.Key, &b, nil, list)
+		err := c.B.Call("GET", fmt.Sprintf("/invoices/%v/lines", invoiceID), c.Key, &b, nil, list)
 
 		ret := make([]interface{}, len(list.Values))
 		for i, v := range list.Values {
@@ -257,7 +330,7 @@ func (c Client) ListLines(params *stripe.InvoiceLineListParams) *LineIter {
 		}
 
 		return ret, list.ListMeta, err
-	})}
+	}
 }
 
 // Iter is an iterator for lists of Invoices.
@@ -287,5 +360,5 @@ func (i *LineIter) InvoiceLine() *stripe.InvoiceLine {
 }
 
 func getC() Client {
-	return Client{stripe.GetBackend(stripe.APIBackend), stripe.Key}
+	return Client{B: stripe.GetBackend(stripe.APIBackend), Key: stripe.Key}
 }
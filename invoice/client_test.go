@@ -1,6 +1,8 @@
 package invoice
 
 import (
+	"io"
+	"net/url"
 	"testing"
 
 	stripe "github.com/channelmeter/stripe-go"
@@ -259,3 +261,114 @@ func TestAllInvoicesScenarios(t *testing.T) {
 
 	customer.Del(cust.ID)
 }
+
+func TestInvoiceLifecycle(t *testing.T) {
+	cust, err := customer.New(&stripe.CustomerParams{Email: "lifecycle@example.com"})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = invoiceitem.New(&stripe.InvoiceItemParams{
+		Customer: cust.ID,
+		Amount:   100,
+		Currency: currency.USD,
+	})
+
+	if err != nil {
+		t.Error(err)
+	}
+
+	target, err := New(&stripe.InvoiceParams{Customer: cust.ID, NoAutoAdvance: true})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if target.Status != DraftInvoice {
+		t.Errorf("Status %q does not match expected status %q\n", target.Status, DraftInvoice)
+	}
+
+	target, err = Finalize(target.ID, &stripe.InvoiceFinalizeParams{NoAutoAdvance: true})
+
+	if err != nil {
+		t.Error(err)
+	}
+
+	if target.Status != OpenInvoice {
+		t.Errorf("Status %q does not match expected status %q\n", target.Status, OpenInvoice)
+	}
+
+	target, err = MarkUncollectible(target.ID, nil)
+
+	if err != nil {
+		t.Error(err)
+	}
+
+	if target.Status != UncollectibleInvoice {
+		t.Errorf("Status %q does not match expected status %q\n", target.Status, UncollectibleInvoice)
+	}
+
+	target, err = Void(target.ID, nil)
+
+	if err != nil {
+		t.Error(err)
+	}
+
+	if target.Status != VoidInvoice {
+		t.Errorf("Status %q does not match expected status %q\n", target.Status, VoidInvoice)
+	}
+
+	draft, err := New(&stripe.InvoiceParams{Customer: cust.ID})
+
+	if err == nil {
+		if err = Del(draft.ID); err != nil {
+			t.Error(err)
+		}
+	}
+
+	customer.Del(cust.ID)
+}
+
+// pathBackend records the requests made to it and returns empty pages.
+type pathBackend struct {
+	calls []string
+}
+
+func (b *pathBackend) Call(method, path, key string, body *url.Values, params *stripe.Params, v interface{}) error {
+	b.calls = append(b.calls, path+"?"+body.Encode())
+	return nil
+}
+
+func (b *pathBackend) CallMultipart(method, path, key, boundary string, body io.Reader, params *stripe.Params, v interface{}) error {
+	return nil
+}
+
+func TestInvoiceListLinesFromUpcoming(t *testing.T) {
+	b := &pathBackend{}
+	c := Client{B: b, Key: stripe.Key}
+
+	inv := &stripe.Invoice{
+		Customer: &stripe.Customer{ID: "cus_123"},
+		Sub:      "sub_123",
+		Lines:    &stripe.InvoiceLineList{ListMeta: stripe.ListMeta{More: true}},
+	}
+
+	i := c.ListLinesFrom(inv)
+	for i.Next() {
+	}
+
+	if err := i.Err(); err != nil {
+		t.Error(err)
+	}
+
+	want := "/invoices/upcoming/lines?customer=cus_123&subscription=sub_123"
+	if len(b.calls) != 1 || b.calls[0] != want {
+		t.Errorf("calls = %v want [%v]\n", b.calls, want)
+	}
+
+	i = c.ListLinesFrom(&stripe.Invoice{})
+	if i.Next() || i.Err() == nil {
+		t.Errorf("Listing the lines of an upcoming invoice without a customer should have failed\n")
+	}
+}
@@ -1,39 +1,79 @@
 package stripe
 
-import "encoding/json"
-
 // InvoiceItemParams is the set of parameters that can be used when creating or updating an invoice item.
 // For more details see https://stripe.com/docs/api#create_invoiceitem and https://stripe.com/docs/api#update_invoiceitem.
 type InvoiceItemParams struct {
 	Params
-	Customer           string
-	Amount             int64
-	Currency           Currency
-	Invoice, Desc, Sub string
+	Customer string   `form:"customer"`
+	Amount   int64    `form:"amount"`
+	Currency Currency `form:"currency"`
+	Price    string   `form:"price"`
+	Quantity uint64   `form:"quantity"`
+	Invoice  string   `form:"invoice"`
+	Desc     string   `form:"description"`
+	Sub      string   `form:"subscription"`
+	TaxRates []string `form:"tax_rates"`
+}
+
+// Validate checks the invoice item parameters before they are sent.
+func (p *InvoiceItemParams) Validate() error {
+	v := &validation{}
+	p.Params.validate(v)
+
+	// currency or a price is only sent when creating an invoice item
+	if len(p.Price) > 0 {
+		v.required("customer", p.Customer)
+
+		if p.Amount != 0 || len(p.Currency) > 0 {
+			v.add("price", "cannot be used together with an amount and currency")
+		}
+	} else if len(p.Currency) > 0 {
+		v.required("customer", p.Customer)
+
+		if p.Amount == 0 {
+			v.add("amount", "is required")
+		}
+	}
+
+	return v.err()
 }
 
 // InvoiceItemListParams is the set of parameters that can be used when listing invoice items.
 // For more details see https://stripe.com/docs/api#list_invoiceitems.
 type InvoiceItemListParams struct {
 	ListParams
-	Created  int64
-	Customer string
+	Created      Timestamp    `form:"created"`
+	CreatedRange *RangeParams `form:"created"`
+	Customer     string       `form:"customer"`
+}
+
+// Validate checks the invoice item list parameters before they are sent.
+func (p *InvoiceItemListParams) Validate() error {
+	v := &validation{}
+	p.ListParams.validate(v)
+	v.timeRange("created", p.Created, p.CreatedRange)
+	return v.err()
 }
 
 // InvoiceItem is the resource represneting a Stripe invoice item.
 // For more details see https://stripe.com/docs/api#invoiceitems.
 type InvoiceItem struct {
+	Extra
 	ID        string            `json:"id"`
 	Live      bool              `json:"livemode"`
 	Amount    int64             `json:"amount"`
 	Currency  Currency          `json:"currency"`
 	Customer  *Customer         `json:"customer"`
-	Date      int64             `json:"date"`
+	Date      Timestamp         `json:"date"`
 	Proration bool              `json:"proration"`
 	Desc      string            `json:"description"`
 	Invoice   *Invoice          `json:"invoice"`
 	Meta      map[string]string `json:"metadata"`
+	Price     *Price            `json:"price"`
+	Quantity  uint64            `json:"quantity"`
 	Sub       string            `json:"subscription"`
+	TaxRates  []*TaxRate        `json:"tax_rates"`
+	Expanded  bool              `json:"-"`
 }
 
 // UnmarshalJSON handles deserialization of an InvoiceItem.
@@ -41,14 +81,5 @@ type InvoiceItem struct {
 // property may be an id or the full struct if it was expanded.
 func (i *InvoiceItem) UnmarshalJSON(data []byte) error {
 	type invoiceitem InvoiceItem
-	var ii invoiceitem
-	err := json.Unmarshal(data, &ii)
-	if err == nil {
-		*i = InvoiceItem(ii)
-	} else {
-		// the id is surrounded by "\" characters, so strip them
-		i.ID = string(data[1 : len(data)-1])
-	}
-
-	return nil
+	return unmarshalExpandable(data, &i.ID, &i.Expanded, (*invoiceitem)(i))
 }
@@ -3,15 +3,18 @@ package invoiceitem
 
 import (
 	"net/url"
-	"strconv"
 
 	stripe "github.com/channelmeter/stripe-go"
+	"github.com/channelmeter/stripe-go/form"
 )
 
 // Client is used to invoke /invoiceitems APIs.
 type Client struct {
 	B   stripe.Backend
 	Key string
+	// NoValidate disables the client-side validation of params
+	// that is otherwise done before calling the backend.
+	NoValidate bool
 }
 
 // New POSTs new invoice items.
@@ -21,25 +24,12 @@ func New(params *stripe.InvoiceItemParams) (*stripe.InvoiceItem, error) {
 }
 
 func (c Client) New(params *stripe.InvoiceItemParams) (*stripe.InvoiceItem, error) {
-	body := &url.Values{
-		"customer": {params.Customer},
-		"amount":   {strconv.FormatInt(params.Amount, 10)},
-		"currency": {string(params.Currency)},
+	if err := stripe.ValidateParams(params, c.NoValidate); err != nil {
+		return nil, err
 	}
 
-	if len(params.Invoice) > 0 {
-		body.Add("invoice", params.Invoice)
-	}
-
-	if len(params.Desc) > 0 {
-		body.Add("description", params.Desc)
-	}
-
-	if len(params.Sub) > 0 {
-		body.Add("subscription", params.Sub)
-	}
-
-	params.AppendTo(body)
+	body := &url.Values{}
+	form.AppendTo(body, params)
 
 	invoiceItem := &stripe.InvoiceItem{}
 	err := c.B.Call("POST", "/invoiceitems", c.Key, body, &params.Params, invoiceItem)
@@ -76,22 +66,17 @@ func Update(id string, params *stripe.InvoiceItemParams) (*stripe.InvoiceItem, e
 }
 
 func (c Client) Update(id string, params *stripe.InvoiceItemParams) (*stripe.InvoiceItem, error) {
+	if err := stripe.ValidateParams(params, c.NoValidate); err != nil {
+		return nil, err
+	}
+
 	var body *url.Values
 	var commonParams *stripe.Params
 
 	if params != nil {
 		commonParams = &params.Params
 		body = &url.Values{}
-
-		if params.Amount != 0 {
-			body.Add("amount", strconv.FormatInt(params.Amount, 10))
-		}
-
-		if len(params.Desc) > 0 {
-			body.Add("description", params.Desc)
-		}
-
-		params.AppendTo(body)
+		form.AppendTo(body, params)
 	}
 
 	invoiceItem := &stripe.InvoiceItem{}
@@ -117,6 +102,10 @@ func List(params *stripe.InvoiceItemListParams) *Iter {
 }
 
 func (c Client) List(params *stripe.InvoiceItemListParams) *Iter {
+	if err := stripe.ValidateParams(params, c.NoValidate); err != nil {
+		return &Iter{stripe.GetIterErr(err)}
+	}
+
 	type invoiceItemList struct {
 		stripe.ListMeta
 		Values []*stripe.InvoiceItem `json:"data"`
@@ -127,16 +116,7 @@ func (c Client) List(params *stripe.InvoiceItemListParams) *Iter {
 
 	if params != nil {
 		body = &url.Values{}
-
-		if params.Created > 0 {
-			body.Add("created", strconv.FormatInt(params.Created, 10))
-		}
-
-		if len(params.Customer) > 0 {
-			body.Add("customer", params.Customer)
-		}
-
-		params.AppendTo(body)
+		form.AppendTo(body, params)
 		lp = &params.ListParams
 	}
 
@@ -167,5 +147,5 @@ func (i *Iter) InvoiceItem() *stripe.InvoiceItem {
 }
 
 func getC() Client {
-	return Client{stripe.GetBackend(stripe.APIBackend), stripe.Key}
+	return Client{B: stripe.GetBackend(stripe.APIBackend), Key: stripe.Key}
 }
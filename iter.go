@@ -1,10 +1,21 @@
 package stripe
 
 import (
+	"encoding/base64"
+	"errors"
 	"net/url"
 	"reflect"
+	"strconv"
+	"strings"
 )
 
+// cursorVersion prefixes the content of the tokens returned by Iter.Cursor.
+const cursorVersion = "1:"
+
+// ErrCursorUnsupported is returned by Iter.Cursor for sharded iterations,
+// whose windows are fetched concurrently and cannot be resumed.
+var ErrCursorUnsupported = errors.New("Cursor is not supported for sharded iterations")
+
 // Query is the function used to get a page listing.
 type Query func(url.Values) ([]interface{}, ListMeta, error)
 
@@ -24,10 +35,51 @@ type Iter struct {
 	params ListParams
 	err    error
 	cur    interface{}
+
+	// next receives the page fetched in the background when prefetching.
+	next chan page
+
+	// shards are the windows left to consume in a sharded iteration,
+	// whose background fetching stops when done is closed.
+	shards []*shard
+	done   chan struct{}
 }
 
 // GetIter returns a new Iter for a given query and its options.
 func GetIter(params *ListParams, qs *url.Values, query Query) *Iter {
+	iter := newIter(params, qs, query)
+	if iter.err != nil {
+		return iter
+	}
+
+	if iter.params.Shard != nil {
+		if err := iter.startShards(); err != nil {
+			iter.err = err
+			return iter
+		}
+	}
+
+	iter.getPage()
+	return iter
+}
+
+// GetIterFrom returns a new Iter that starts with an already fetched page,
+// such as a list embedded in another resource, and uses the query to fetch
+// the pages that follow it. When the page is empty but has more items,
+// iteration starts from the first page. Sharding is not supported.
+func GetIterFrom(params *ListParams, qs *url.Values, values []interface{}, meta ListMeta, query Query) *Iter {
+	iter := newIter(params, qs, query)
+
+	if len(values) == 0 && meta.More {
+		iter.getPage()
+	} else {
+		iter.values, iter.meta = values, meta
+	}
+
+	return iter
+}
+
+func newIter(params *ListParams, qs *url.Values, query Query) *Iter {
 	iter := &Iter{}
 	iter.query = query
 
@@ -43,17 +95,77 @@ func GetIter(params *ListParams, qs *url.Values, query Query) *Iter {
 	}
 	iter.qs = *q
 
-	iter.getPage()
+	if p.Limit > maxLimit {
+		iter.qs.Set("limit", strconv.Itoa(maxLimit))
+	}
+
+	if len(p.Cursor) > 0 {
+		iter.qs, iter.err = decodeCursor(p.Cursor)
+		iter.params.Start = iter.qs.Get(startafter)
+		iter.params.End = iter.qs.Get(endbefore)
+	}
+
 	return iter
 }
 
+// GetIterErr returns an Iter that stops immediately with the given error.
+// It is used when a list request cannot be made, e.g. when its params are invalid.
+func GetIterErr(err error) *Iter {
+	return &Iter{err: err}
+}
+
 func (it *Iter) getPage() {
-	it.values, it.meta, it.err = it.query(it.qs)
+	switch {
+	case it.done != nil:
+		p := it.nextShardPage()
+		it.values, it.meta, it.err = p.values, p.meta, p.err
+		if it.err != nil || !it.meta.More {
+			it.Close()
+		}
+		return
+
+	case it.next != nil:
+		p := <-it.next
+		it.next = nil
+		it.values, it.meta, it.err = p.values, p.meta, p.err
+
+	default:
+		it.values, it.meta, it.err = it.query(it.qs)
+	}
+
 	if it.params.End != "" {
 		// We are moving backward,
 		// but items arrive in forward order.
 		reverse(it.values)
 	}
+
+	if it.params.Prefetch && it.err == nil && it.meta.More && !it.params.Single && len(it.values) > 0 {
+		it.prefetch()
+	}
+}
+
+// prefetch starts fetching the page following the current one, using the
+// same cursor that Next will set once the current page is consumed.
+func (it *Iter) prefetch() {
+	qs := url.Values{}
+	for k, v := range it.qs {
+		qs[k] = v
+	}
+
+	id := listItemID(it.values[len(it.values)-1])
+	if it.params.End != "" {
+		qs.Set(endbefore, id)
+	} else {
+		qs.Set(startafter, id)
+	}
+
+	next := make(chan page, 1)
+	go func() {
+		values, meta, err := it.query(qs)
+		next <- page{values, meta, err}
+	}()
+
+	it.next = next
 }
 
 // Next advances the Iter to the next item in the list,
@@ -63,11 +175,14 @@ func (it *Iter) getPage() {
 // at the end of the list.
 func (it *Iter) Next() bool {
 	if len(it.values) == 0 && it.meta.More && !it.params.Single {
-		// determine if we're moving forward or backwards in paging
-		if it.params.End != "" {
+		// determine if we're moving forward or backwards in paging,
+		// the windows of a sharded iteration keep their own cursors
+		switch {
+		case it.done != nil:
+		case it.params.End != "":
 			it.params.End = listItemID(it.cur)
 			it.qs.Set(endbefore, it.params.End)
-		} else {
+		default:
 			it.params.Start = listItemID(it.cur)
 			it.qs.Set(startafter, it.params.Start)
 		}
@@ -81,6 +196,55 @@ func (it *Iter) Next() bool {
 	return true
 }
 
+// Close stops the background fetching of a sharded iteration that is not
+// consumed to its end. It is safe to call Close more than once.
+func (it *Iter) Close() {
+	if it.done == nil {
+		return
+	}
+
+	select {
+	case <-it.done:
+	default:
+		close(it.done)
+	}
+}
+
+// Cursor returns an opaque token for the position of the iterator, just
+// after the most recent item visited by a call to Next. Setting it as the
+// Cursor of the list params of a new iterator resumes the listing there,
+// for example after a batch job has been restarted.
+func (it *Iter) Cursor() (string, error) {
+	if it.done != nil {
+		return "", ErrCursorUnsupported
+	}
+
+	qs := url.Values{}
+	for k, v := range it.qs {
+		qs[k] = v
+	}
+
+	if it.cur != nil {
+		if it.params.End != "" {
+			qs.Set(endbefore, listItemID(it.cur))
+		} else {
+			qs.Set(startafter, listItemID(it.cur))
+		}
+	}
+
+	return base64.URLEncoding.EncodeToString([]byte(cursorVersion + qs.Encode())), nil
+}
+
+// decodeCursor returns the query string held by a token of Iter.Cursor.
+func decodeCursor(cursor string) (url.Values, error) {
+	b, err := base64.URLEncoding.DecodeString(cursor)
+	if err != nil || !strings.HasPrefix(string(b), cursorVersion) {
+		return nil, errors.New("Invalid list cursor")
+	}
+
+	return url.ParseQuery(strings.TrimPrefix(string(b), cursorVersion))
+}
+
 // Current returns the most recent item
 // visited by a call to Next.
 func (it *Iter) Current() interface{} {
@@ -170,6 +170,57 @@ func TestIterReversedTwoPages(t *testing.T) {
 	}
 }
 
+func TestIterFrom(t *testing.T) {
+	var starts []string
+	tq := testQuery{{[]interface{}{2}, ListMeta{}, nil}}
+	query := func(qs url.Values) ([]interface{}, ListMeta, error) {
+		starts = append(starts, qs.Get(startafter))
+		return tq.query(qs)
+	}
+
+	want := []interface{}{&item{"x"}, 2}
+	g, gerr := collect(GetIterFrom(nil, nil, []interface{}{&item{"x"}}, ListMeta{0, true, ""}, query))
+	if len(tq) != 0 {
+		t.Fatalf("expect all pages to be fetched")
+	}
+	if !reflect.DeepEqual(g, want) {
+		t.Fatalf("results = %v want %v", g, want)
+	}
+	if !reflect.DeepEqual(starts, []string{"x"}) {
+		t.Fatalf("starting_after = %v want [x]", starts)
+	}
+	if gerr != nil {
+		t.Fatalf("err = %v want nil", gerr)
+	}
+}
+
+func TestIterFromEmpty(t *testing.T) {
+	tq := testQuery{{[]interface{}{1}, ListMeta{}, nil}}
+	want := []interface{}{1}
+	g, gerr := collect(GetIterFrom(nil, nil, nil, ListMeta{0, true, ""}, tq.query))
+	if len(tq) != 0 {
+		t.Fatalf("expect all pages to be fetched")
+	}
+	if !reflect.DeepEqual(g, want) {
+		t.Fatalf("results = %v want %v", g, want)
+	}
+	if gerr != nil {
+		t.Fatalf("err = %v want nil", gerr)
+	}
+}
+
+func TestIterFromComplete(t *testing.T) {
+	tq := testQuery{}
+	want := []interface{}{1, 2}
+	g, gerr := collect(GetIterFrom(nil, nil, []interface{}{1, 2}, ListMeta{}, tq.query))
+	if !reflect.DeepEqual(g, want) {
+		t.Fatalf("results = %v want %v", g, want)
+	}
+	if gerr != nil {
+		t.Fatalf("err = %v want nil", gerr)
+	}
+}
+
 var errTest = errors.New("test error")
 
 type item struct {
@@ -215,3 +266,51 @@ func TestReverse(t *testing.T) {
 		}
 	}
 }
+
+func TestIterCursor(t *testing.T) {
+	query := func(qs url.Values) ([]interface{}, ListMeta, error) {
+		if qs.Get("limit") != "2" {
+			t.Fatalf("limit = %q want 2", qs.Get("limit"))
+		}
+
+		switch qs.Get(startafter) {
+		case "":
+			return []interface{}{&item{"a"}, &item{"b"}}, ListMeta{0, true, ""}, nil
+		case "a":
+			return []interface{}{&item{"b"}, &item{"c"}}, ListMeta{}, nil
+		default:
+			t.Fatalf("starting_after = %q", qs.Get(startafter))
+			return nil, ListMeta{}, nil
+		}
+	}
+
+	it := GetIter(&ListParams{Limit: 2}, &url.Values{"limit": {"2"}}, query)
+	if !it.Next() {
+		t.Fatalf("Next() = false want true")
+	}
+
+	cursor, err := it.Cursor()
+	if err != nil {
+		t.Fatalf("Cursor() err = %v want nil", err)
+	}
+
+	g, gerr := collect(GetIter(&ListParams{Cursor: cursor}, nil, query))
+	want := []interface{}{&item{"b"}, &item{"c"}}
+	if !reflect.DeepEqual(g, want) {
+		t.Fatalf("results = %v want %v", g, want)
+	}
+	if gerr != nil {
+		t.Fatalf("err = %v want nil", gerr)
+	}
+}
+
+func TestIterCursorInvalid(t *testing.T) {
+	tq := testQuery{}
+	g, gerr := collect(GetIter(&ListParams{Cursor: "not a cursor"}, nil, tq.query))
+	if len(g) != 0 {
+		t.Fatalf("results = %v want empty", g)
+	}
+	if gerr == nil {
+		t.Fatalf("err = nil want an error")
+	}
+}
@@ -5,33 +5,68 @@ import (
 	"encoding/base64"
 	"fmt"
 	"net/url"
-	"strconv"
 	"time"
+
+	"github.com/channelmeter/stripe-go/form"
 )
 
 const (
 	startafter = "starting_after"
 	endbefore  = "ending_before"
+
+	// maxLimit is the largest page size accepted by the API.
+	maxLimit = 100
 )
 
 // Params is the structure that contains the common properties
 // of any *Params structure.
 type Params struct {
-	Exp                     []string
-	Meta                    map[string]string
-	IdempotencyKey, Account string
+	Exp                     []string          `form:"expand"`
+	Meta                    map[string]string `form:"metadata"`
+	IdempotencyKey, Account string            `form:"-"`
+	// Version overrides the API version the request is made with,
+	// which is otherwise the version supported by the binding. Only the
+	// resource requested follows it: the Charge, Customer, Invoice and Sub
+	// types are modeled on the version supported by the binding, so when
+	// they are expanded under another version, fields that version dropped
+	// or renamed, such as Customer.Sources, are silently left empty.
+	Version string `form:"-"`
+
+	// zero holds the keys of the fields sent even when they hold their
+	// zero value; see SetZero.
+	zero map[string]bool
 }
 
 // ListParams is the structure that contains the common properties
 // of any *ListParams structure.
 type ListParams struct {
-	Start, End string
-	Limit      int
-	Filters    Filters
+	Start   string  `form:"starting_after"`
+	End     string  `form:"ending_before"`
+	Limit   int     `form:"limit"`
+	Filters Filters `form:",inline"`
 	// By default, listing through an iterator will automatically grab
 	// additional pages as the query progresses. To change this behavior
 	// and just load a single page, set this to true.
-	Single bool
+	Single bool `form:"-"`
+	// Prefetch makes an iterator fetch the next page in the background
+	// while the current one is being consumed.
+	Prefetch bool `form:"-"`
+	// Shard splits the listing into time windows fetched concurrently;
+	// see ShardParams.
+	Shard *ShardParams `form:"-"`
+	// Cursor resumes the listing at the position returned by Iter.Cursor.
+	// It replaces all the other list params and filters.
+	Cursor string `form:"-"`
+}
+
+// RangeParams is a range of times used to filter lists, such as the charges
+// created during a month. It is sent as created[gte]=..., and bounds left
+// at their zero value are not sent.
+type RangeParams struct {
+	GreaterThan        Timestamp `form:"gt"`
+	GreaterThanOrEqual Timestamp `form:"gte"`
+	LesserThan         Timestamp `form:"lt"`
+	LesserThanOrEqual  Timestamp `form:"lte"`
 }
 
 // ListMeta is the structure that contains the common properties
@@ -63,6 +98,42 @@ func NewIdempotencyKey() string {
 	return fmt.Sprintf("%v_%v", now, base64.URLEncoding.EncodeToString(buf)[:6])
 }
 
+// Between returns the range of times from start included to end excluded.
+// Either of them can be left at its zero value for an open range.
+func Between(start, end time.Time) *RangeParams {
+	return &RangeParams{GreaterThanOrEqual: NewTimestamp(start), LesserThan: NewTimestamp(end)}
+}
+
+// Since returns the range of times from start included.
+func Since(start time.Time) *RangeParams {
+	return &RangeParams{GreaterThanOrEqual: NewTimestamp(start)}
+}
+
+// Before returns the range of times up to end excluded.
+func Before(end time.Time) *RangeParams {
+	return &RangeParams{LesserThan: NewTimestamp(end)}
+}
+
+// CurrentVersion returns a copy of p, which may be nil, requesting
+// CurrentAPIVersion unless p already asks for a version. The clients of the
+// resources that apiversion returns in an older shape use it for every call.
+// The older resources they embed, such as a PaymentIntent's Customer or
+// Charges, are decoded into their apiversion types and so miss the fields
+// CurrentAPIVersion no longer returns; fetch them with their own clients
+// rather than expanding them when those fields are needed.
+func CurrentVersion(p *Params) *Params {
+	v := Params{}
+	if p != nil {
+		v = *p
+	}
+
+	if len(v.Version) == 0 {
+		v.Version = CurrentAPIVersion
+	}
+
+	return &v
+}
+
 // SetAccount sets a value for the Stripe-Account header.
 func (p *Params) SetAccount(val string) {
 	p.Account = val
@@ -82,6 +153,34 @@ func (p *Params) AddMeta(key, value string) {
 	p.Meta[key] = value
 }
 
+// UnsetMeta removes a key from the Metadata when the params are sent.
+func (p *Params) UnsetMeta(key string) {
+	p.AddMeta(key, "")
+}
+
+// SetZero marks fields to be sent even when they hold their zero value.
+// Fields left at their zero value are otherwise considered unset and not
+// sent at all, so this is how a quantity or an amount is set to 0, how
+// a string such as a description is cleared and how a list such as the
+// tax rates of a subscription is emptied.
+// The fields are named by their form key relative to the params, for
+// example "quantity" or "legal_entity[dob][day]".
+func (p *Params) SetZero(fields ...string) {
+	if p.zero == nil {
+		p.zero = make(map[string]bool)
+	}
+
+	for _, f := range fields {
+		p.zero[f] = true
+	}
+}
+
+// SendZero implements form.Zeroer, reporting whether the field was passed
+// to SetZero.
+func (p *Params) SendZero(key string) bool {
+	return p.zero[key]
+}
+
 // AddFilter adds a new filter with a given key, op and value.
 func (f *Filters) AddFilter(key, op, value string) {
 	filter := &filter{Key: key, Op: op, Val: value}
@@ -90,45 +189,32 @@ func (f *Filters) AddFilter(key, op, value string) {
 
 // AppendTo adds the common parameters to the query string values.
 func (p *Params) AppendTo(body *url.Values) {
-	for k, v := range p.Meta {
-		body.Add(fmt.Sprintf("metadata[%v]", k), v)
-	}
-
-	for _, v := range p.Exp {
-		body.Add("expand[]", v)
-	}
+	form.AppendTo(body, p)
 }
 
 // AppendTo adds the common parameters to the query string values.
 func (p *ListParams) AppendTo(body *url.Values) {
-	if len(p.Filters.f) > 0 {
-		p.Filters.AppendTo(body)
+	if p.Limit > maxLimit {
+		p.Limit = maxLimit
 	}
 
-	if len(p.Start) > 0 {
-		body.Add(startafter, p.Start)
-	}
-
-	if len(p.End) > 0 {
-		body.Add(endbefore, p.End)
-	}
-
-	if p.Limit > 0 {
-		if p.Limit > 100 {
-			p.Limit = 100
-		}
-
-		body.Add("limit", strconv.Itoa(p.Limit))
-	}
+	form.AppendTo(body, p)
 }
 
 // AppendTo adds the list of filters to the query string values.
 func (f *Filters) AppendTo(values *url.Values) {
+	f.AppendForm(values, nil)
+}
+
+// AppendForm implements form.Appender for filters, which are passed as
+// key[op]=value.
+func (f *Filters) AppendForm(values *url.Values, keyParts []string) {
 	for _, v := range f.f {
+		parts := append(keyParts[:len(keyParts):len(keyParts)], v.Key)
 		if len(v.Op) > 0 {
-			values.Add(fmt.Sprintf("%v[%v]", v.Key, v.Op), v.Val)
-		} else {
-			values.Add(v.Key, v.Val)
+			parts = append(parts, v.Op)
 		}
+
+		values.Add(form.FormatKey(parts), v.Val)
 	}
 }
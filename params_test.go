@@ -0,0 +1,553 @@
+package stripe
+
+import (
+	"net/url"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/channelmeter/stripe-go/form"
+)
+
+func encode(params interface{}) url.Values {
+	values := &url.Values{}
+	form.AppendTo(values, params)
+	return *values
+}
+
+func TestParamsEncoding(t *testing.T) {
+	params := &Params{}
+	params.AddMeta("order", "6735")
+	params.Expand("customer")
+	params.IdempotencyKey = "key"
+
+	want := url.Values{
+		"metadata[order]": {"6735"},
+		"expand[]":        {"customer"},
+	}
+
+	if got := encode(params); !reflect.DeepEqual(got, want) {
+		t.Errorf("values = %v want %v", got, want)
+	}
+}
+
+func TestParamsSetZero(t *testing.T) {
+	params := &CustomerParams{Desc: "VIP"}
+	params.SetZero("account_balance", "email")
+	params.UnsetMeta("order")
+
+	want := url.Values{
+		"account_balance": {"0"},
+		"description":     {"VIP"},
+		"email":           {""},
+		"metadata[order]": {""},
+	}
+
+	if got := encode(params); !reflect.DeepEqual(got, want) {
+		t.Errorf("values = %v want %v", got, want)
+	}
+
+	account := &AccountParams{LegalEntity: &LegalEntity{}}
+	account.SetZero("legal_entity[dob][day]")
+
+	want = url.Values{"legal_entity[dob][day]": {"0"}}
+
+	if got := encode(account); !reflect.DeepEqual(got, want) {
+		t.Errorf("values = %v want %v", got, want)
+	}
+}
+
+func TestListParamsEncoding(t *testing.T) {
+	params := &ChargeListParams{Customer: "cus_123"}
+	params.Start = "ch_123"
+	params.Limit = 50
+	params.Filters.AddFilter("created", "gte", "1424304000")
+
+	want := url.Values{
+		"customer":       {"cus_123"},
+		"starting_after": {"ch_123"},
+		"limit":          {"50"},
+		"created[gte]":   {"1424304000"},
+	}
+
+	if got := encode(params); !reflect.DeepEqual(got, want) {
+		t.Errorf("values = %v want %v", got, want)
+	}
+}
+
+func TestListParamsRangeEncoding(t *testing.T) {
+	start := time.Date(2015, time.May, 1, 0, 0, 0, 0, time.UTC)
+	end := start.AddDate(0, 1, 0)
+
+	params := &ChargeListParams{CreatedRange: Between(start, end)}
+	params.Limit = 10
+
+	want := url.Values{
+		"created[gte]": {"1430438400"},
+		"created[lt]":  {"1433116800"},
+		"limit":        {"10"},
+	}
+
+	if got := encode(params); !reflect.DeepEqual(got, want) {
+		t.Errorf("values = %v want %v", got, want)
+	}
+
+	if err := params.Validate(); err != nil {
+		t.Errorf("Validate() = %v want nil", err)
+	}
+}
+
+func TestListLimitIsCapped(t *testing.T) {
+	params := &ListParams{Limit: 500, Single: true}
+	values := encode(params)
+
+	var got string
+	GetIter(params, &values, func(b url.Values) ([]interface{}, ListMeta, error) {
+		got = b.Get("limit")
+		return nil, ListMeta{}, nil
+	})
+
+	if got != "100" {
+		t.Errorf("limit = %q want 100", got)
+	}
+}
+
+func TestChargeParamsEncoding(t *testing.T) {
+	params := &ChargeParams{
+		Amount:    1000,
+		Currency:  "usd",
+		NoCapture: true,
+		Source: &SourceParams{
+			Card: &CardParams{Number: "4242424242424242", Month: "10", Year: "20"},
+		},
+	}
+	params.AddMeta("foo", "bar")
+
+	want := url.Values{
+		"amount":          {"1000"},
+		"currency":        {"usd"},
+		"capture":         {"false"},
+		"card[object]":    {"card"},
+		"card[number]":    {"4242424242424242"},
+		"card[exp_month]": {"10"},
+		"card[exp_year]":  {"20"},
+		"metadata[foo]":   {"bar"},
+	}
+
+	if got := encode(params); !reflect.DeepEqual(got, want) {
+		t.Errorf("values = %v want %v", got, want)
+	}
+
+	params = &ChargeParams{Customer: "cus_123", Source: &SourceParams{Token: "tok_123"}}
+	if got := encode(params).Get("source"); got != "tok_123" {
+		t.Errorf("source = %q want tok_123", got)
+	}
+}
+
+func TestPaymentIntentParamsEncoding(t *testing.T) {
+	params := &PaymentIntentParams{
+		Amount:             2000,
+		Currency:           "usd",
+		PaymentMethodTypes: []string{"card"},
+		CaptureMethod:      "manual",
+		TransferDest:       "acct_123",
+	}
+
+	want := url.Values{
+		"amount":                     {"2000"},
+		"currency":                   {"usd"},
+		"payment_method_types[]":     {"card"},
+		"capture_method":             {"manual"},
+		"transfer_data[destination]": {"acct_123"},
+	}
+
+	if got := encode(params); !reflect.DeepEqual(got, want) {
+		t.Errorf("values = %v want %v", got, want)
+	}
+
+	capture := &PaymentIntentCaptureParams{Amount: 1500}
+	if got := encode(capture).Get("amount_to_capture"); got != "1500" {
+		t.Errorf("amount_to_capture = %q want 1500", got)
+	}
+}
+
+func TestPaymentMethodParamsEncoding(t *testing.T) {
+	params := &PaymentMethodParams{
+		Type: "card",
+		Card: &PaymentMethodCardParams{Number: "4242424242424242", Month: "10", Year: "20"},
+		BillingDetails: &BillingDetails{
+			Name:    "Jane",
+			Address: &Address{Zip: "94107"},
+		},
+	}
+
+	want := url.Values{
+		"type":                                  {"card"},
+		"card[number]":                          {"4242424242424242"},
+		"card[exp_month]":                       {"10"},
+		"card[exp_year]":                        {"20"},
+		"billing_details[name]":                 {"Jane"},
+		"billing_details[address][postal_code]": {"94107"},
+	}
+
+	if got := encode(params); !reflect.DeepEqual(got, want) {
+		t.Errorf("values = %v want %v", got, want)
+	}
+
+	customer := &CustomerParams{InvoiceSettings: &CustomerInvoiceSettingsParams{DefaultPaymentMethod: "pm_123"}}
+	if got := encode(customer).Get("invoice_settings[default_payment_method]"); got != "pm_123" {
+		t.Errorf("invoice_settings[default_payment_method] = %q want pm_123", got)
+	}
+}
+
+func TestPriceParamsEncoding(t *testing.T) {
+	params := &PriceParams{
+		Currency:      "usd",
+		ProductData:   &PriceProductDataParams{Name: "Seats"},
+		Recurring:     &PriceRecurringParams{Interval: "month"},
+		BillingScheme: "tiered",
+		TiersMode:     "graduated",
+		Tiers: []*PriceTierParams{
+			{UpTo: 10, UnitAmount: 500},
+			{UpToInf: true, UnitAmount: 400, FlatAmount: 100},
+		},
+	}
+
+	want := url.Values{
+		"currency":              {"usd"},
+		"product_data[name]":    {"Seats"},
+		"recurring[interval]":   {"month"},
+		"billing_scheme":        {"tiered"},
+		"tiers_mode":            {"graduated"},
+		"tiers[0][up_to]":       {"10"},
+		"tiers[0][unit_amount]": {"500"},
+		"tiers[1][up_to]":       {"inf"},
+		"tiers[1][flat_amount]": {"100"},
+		"tiers[1][unit_amount]": {"400"},
+	}
+
+	if got := encode(params); !reflect.DeepEqual(got, want) {
+		t.Errorf("values = %v want %v", got, want)
+	}
+
+	list := &PriceListParams{Inactive: true, LookupKeys: []string{"basic", "pro"}}
+	want = url.Values{
+		"active":        {"false"},
+		"lookup_keys[]": {"basic", "pro"},
+	}
+
+	if got := encode(list); !reflect.DeepEqual(got, want) {
+		t.Errorf("values = %v want %v", got, want)
+	}
+}
+
+func TestUsageRecordParamsEncoding(t *testing.T) {
+	params := &UsageRecordParams{SubItem: "si_123", Timestamp: 1430438400, Action: "set"}
+
+	want := url.Values{
+		"quantity":  {"0"},
+		"timestamp": {"1430438400"},
+		"action":    {"set"},
+	}
+
+	if got := encode(params); !reflect.DeepEqual(got, want) {
+		t.Errorf("values = %v want %v", got, want)
+	}
+}
+
+func TestCheckoutSessionParamsEncoding(t *testing.T) {
+	params := &CheckoutSessionParams{
+		Mode:       "subscription",
+		LineItems:  []*CheckoutSessionLineItemParams{{Price: "price_base", Quantity: 1}, {Price: "price_seat", Quantity: 5}},
+		SuccessURL: "https://example.com/success",
+		CancelURL:  "https://example.com/cancel",
+		Customer:   "cus_123",
+	}
+	params.AddMeta("order", "6735")
+
+	want := url.Values{
+		"mode":                    {"subscription"},
+		"line_items[0][price]":    {"price_base"},
+		"line_items[0][quantity]": {"1"},
+		"line_items[1][price]":    {"price_seat"},
+		"line_items[1][quantity]": {"5"},
+		"success_url":             {"https://example.com/success"},
+		"cancel_url":              {"https://example.com/cancel"},
+		"customer":                {"cus_123"},
+		"metadata[order]":         {"6735"},
+	}
+
+	if got := encode(params); !reflect.DeepEqual(got, want) {
+		t.Errorf("values = %v want %v", got, want)
+	}
+}
+
+func TestPayoutListParamsEncoding(t *testing.T) {
+	params := &PayoutListParams{
+		Status:       "in_transit",
+		ArrivalRange: &RangeParams{GreaterThanOrEqual: 1430438400},
+	}
+
+	want := url.Values{
+		"status":            {"in_transit"},
+		"arrival_date[gte]": {"1430438400"},
+	}
+
+	if got := encode(params); !reflect.DeepEqual(got, want) {
+		t.Errorf("values = %v want %v", got, want)
+	}
+
+	if got := encode(&TxListParams{Payout: "po_123"}).Get("payout"); got != "po_123" {
+		t.Errorf("payout = %q want po_123", got)
+	}
+}
+
+func TestCardParamsEncoding(t *testing.T) {
+	params := &CardParams{Customer: "cus_123", Name: "Jane", Zip: "94107"}
+	params.AddMeta("foo", "bar")
+
+	// updates send the plain parameter names
+	want := url.Values{
+		"name":          {"Jane"},
+		"address_zip":   {"94107"},
+		"metadata[foo]": {"bar"},
+	}
+
+	if got := encode(params); !reflect.DeepEqual(got, want) {
+		t.Errorf("values = %v want %v", got, want)
+	}
+
+	values := &url.Values{}
+	form.AppendToPrefixed(values, &CardParams{Token: "tok_123"}, []string{"card"})
+
+	if got := values.Get("card"); got != "tok_123" {
+		t.Errorf("card = %q want tok_123", got)
+	}
+}
+
+func TestAccountParamsEncoding(t *testing.T) {
+	params := &AccountParams{
+		Country: "US",
+		Managed: true,
+		LegalEntity: &LegalEntity{
+			Type:    Individual,
+			First:   "Jane",
+			DOB:     DOB{Day: 1, Month: 2, Year: 1990},
+			Address: Address{City: "San Francisco", Zip: "94107"},
+			AdditionalOwners: []Owner{
+				{First: "John", Address: Address{Country: "US"}},
+			},
+		},
+		TransferSchedule: &TransferScheduleParams{Delay: 7, Interval: Week, WeekAnchor: "friday"},
+		BankAccount:      &BankAccountParams{Token: "btok_123"},
+	}
+
+	want := url.Values{
+		"country":                                              {"US"},
+		"managed":                                              {"true"},
+		"legal_entity[type]":                                   {"individual"},
+		"legal_entity[first_name]":                             {"Jane"},
+		"legal_entity[dob][day]":                               {"1"},
+		"legal_entity[dob][month]":                             {"2"},
+		"legal_entity[dob][year]":                              {"1990"},
+		"legal_entity[address][city]":                          {"San Francisco"},
+		"legal_entity[address][postal_code]":                   {"94107"},
+		"legal_entity[additional_owners][0][first_name]":       {"John"},
+		"legal_entity[additional_owners][0][address][country]": {"US"},
+		"transfer_schedule[delay_days]":                        {"7"},
+		"transfer_schedule[interval]":                          {"weekly"},
+		"transfer_schedule[weekly_anchor]":                     {"friday"},
+		"bank_account":                                         {"btok_123"},
+	}
+
+	if got := encode(params); !reflect.DeepEqual(got, want) {
+		t.Errorf("values = %v\nwant %v", got, want)
+	}
+}
+
+func TestSubParamsEncoding(t *testing.T) {
+	params := &SubParams{
+		Customer:    "cus_123",
+		Plan:        "gold",
+		Token:       "tok_123",
+		TrialEndNow: true,
+		NoProrate:   true,
+		TaxPercent:  8.25,
+	}
+	params.SetZero("quantity")
+
+	want := url.Values{
+		"plan":        {"gold"},
+		"card":        {"tok_123"},
+		"trial_end":   {"now"},
+		"quantity":    {"0"},
+		"prorate":     {"false"},
+		"tax_percent": {"8.25"},
+	}
+
+	if got := encode(params); !reflect.DeepEqual(got, want) {
+		t.Errorf("values = %v want %v", got, want)
+	}
+
+	params = &SubParams{Customer: "cus_123", Price: "price_123", Quantity: 3}
+
+	want = url.Values{
+		"items[0][price]":    {"price_123"},
+		"items[0][quantity]": {"3"},
+	}
+
+	if got := encode(params); !reflect.DeepEqual(got, want) {
+		t.Errorf("values = %v want %v", got, want)
+	}
+
+	params = &SubParams{Customer: "cus_123", Price: "price_123", QuantityZero: true}
+
+	want = url.Values{
+		"items[0][price]":    {"price_123"},
+		"items[0][quantity]": {"0"},
+	}
+
+	if got := encode(params); !reflect.DeepEqual(got, want) {
+		t.Errorf("values = %v want %v", got, want)
+	}
+
+	params = &SubParams{
+		Customer: "cus_123",
+		Items: []*SubItemsParams{
+			{ID: "si_123", Quantity: 2},
+			{ID: "si_456", Deleted: true},
+			{Price: "price_123"},
+		},
+		ProrationDate: 1430438400,
+	}
+	params.SetZero("items[2][quantity]")
+
+	want = url.Values{
+		"items[0][id]":       {"si_123"},
+		"items[0][quantity]": {"2"},
+		"items[1][id]":       {"si_456"},
+		"items[1][deleted]":  {"true"},
+		"items[2][price]":    {"price_123"},
+		"items[2][quantity]": {"0"},
+		"proration_date":     {"1430438400"},
+	}
+
+	if got := encode(params); !reflect.DeepEqual(got, want) {
+		t.Errorf("values = %v want %v", got, want)
+	}
+}
+
+func TestInvoiceParamsEncoding(t *testing.T) {
+	if got := encode(&InvoiceParams{Opened: true}).Get("closed"); got != "false" {
+		t.Errorf("closed = %q want false", got)
+	}
+
+	if got := encode(&InvoiceParams{Closed: true}).Get("closed"); got != "true" {
+		t.Errorf("closed = %q want true", got)
+	}
+
+	if got := encode(&InvoiceFinalizeParams{NoAutoAdvance: true}).Get("auto_advance"); got != "false" {
+		t.Errorf("auto_advance = %q want false", got)
+	}
+
+	if got := encode(&InvoiceListParams{Status: "open"}).Get("status"); got != "open" {
+		t.Errorf("status = %q want open", got)
+	}
+}
+
+func TestCreditNoteParamsEncoding(t *testing.T) {
+	params := &CreditNoteParams{
+		Invoice: "in_123",
+		Lines: []*CreditNoteLineParams{
+			{Type: "invoice_line_item", InvoiceLine: "il_123", Quantity: 1},
+			{Type: "custom_line_item", Desc: "Goodwill", Quantity: 1, UnitAmount: 500},
+		},
+		RefundAmount: 1500,
+		Reason:       "order_change",
+	}
+
+	want := url.Values{
+		"invoice":                     {"in_123"},
+		"lines[0][type]":              {"invoice_line_item"},
+		"lines[0][invoice_line_item]": {"il_123"},
+		"lines[0][quantity]":          {"1"},
+		"lines[1][type]":              {"custom_line_item"},
+		"lines[1][description]":       {"Goodwill"},
+		"lines[1][quantity]":          {"1"},
+		"lines[1][unit_amount]":       {"500"},
+		"reason":                      {"order_change"},
+		"refund_amount":               {"1500"},
+	}
+
+	if got := encode(params); !reflect.DeepEqual(got, want) {
+		t.Errorf("values = %v want %v", got, want)
+	}
+}
+
+func TestTaxRateParamsEncoding(t *testing.T) {
+	want := url.Values{
+		"display_name": {"VAT"},
+		"jurisdiction": {"DE"},
+		"percentage":   {"19"},
+		"inclusive":    {"false"},
+	}
+
+	params := &TaxRateParams{DisplayName: "VAT", Jurisdiction: "DE", Percentage: 19, Exclusive: true}
+	if got := encode(params); !reflect.DeepEqual(got, want) {
+		t.Errorf("values = %v want %v", got, want)
+	}
+
+	want = url.Values{
+		"display_name": {"Exempt"},
+		"percentage":   {"0"},
+		"inclusive":    {"false"},
+	}
+
+	params = &TaxRateParams{DisplayName: "Exempt", Exclusive: true}
+	if got := encode(params); !reflect.DeepEqual(got, want) {
+		t.Errorf("values = %v want %v", got, want)
+	}
+
+	sub := &SubParams{Customer: "cus_123", DefaultTaxRates: []string{"txr_123", "txr_456"}}
+	sub.Items = []*SubItemsParams{{ID: "si_123", TaxRates: []string{"txr_789"}}}
+
+	want = url.Values{
+		"default_tax_rates[]":   {"txr_123", "txr_456"},
+		"items[0][id]":          {"si_123"},
+		"items[0][tax_rates][]": {"txr_789"},
+	}
+
+	if got := encode(sub); !reflect.DeepEqual(got, want) {
+		t.Errorf("values = %v want %v", got, want)
+	}
+
+	invoice := &InvoiceParams{}
+	invoice.SetZero("default_tax_rates")
+
+	if got := encode(invoice); !reflect.DeepEqual(got, url.Values{"default_tax_rates": {""}}) {
+		t.Errorf("values = %v want default_tax_rates cleared", got)
+	}
+}
+
+func TestCurrentVersion(t *testing.T) {
+	backend := &BackendConfiguration{APIBackend, apiURL, nil}
+
+	req, err := backend.NewRequest("GET", "/charges", "sk_test", "", nil, nil)
+	if err != nil || req.Header.Get("Stripe-Version") != apiversion {
+		t.Errorf("Stripe-Version = %q, %v want %v", req.Header.Get("Stripe-Version"), err, apiversion)
+	}
+
+	params := &Params{Account: "acct_123"}
+	req, err = backend.NewRequest("GET", "/payment_intents", "sk_test", "", nil, CurrentVersion(params))
+	if err != nil || req.Header.Get("Stripe-Version") != CurrentAPIVersion || req.Header.Get("Stripe-Account") != "acct_123" {
+		t.Errorf("headers = %v, %v want version %v", req.Header, err, CurrentAPIVersion)
+	}
+
+	if len(params.Version) > 0 {
+		t.Errorf("params version = %q want the params left untouched", params.Version)
+	}
+
+	if p := CurrentVersion(&Params{Version: "2019-02-11"}); p.Version != "2019-02-11" {
+		t.Errorf("version = %q want the version asked for", p.Version)
+	}
+}
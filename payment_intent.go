@@ -0,0 +1,228 @@
+package stripe
+
+// PaymentIntentStatus is the list of allowed values for the payment intent's status.
+// Allowed values are "requires_payment_method", "requires_confirmation",
+// "requires_action", "processing", "requires_capture", "canceled", "succeeded".
+type PaymentIntentStatus string
+
+// PaymentIntentCaptureMethod is the list of allowed values for the capture method.
+// Allowed values are "automatic", "manual".
+type PaymentIntentCaptureMethod string
+
+// PaymentIntentConfirmationMethod is the list of allowed values for the confirmation method.
+// Allowed values are "automatic", "manual".
+type PaymentIntentConfirmationMethod string
+
+// PaymentIntentNextActionType is the list of allowed values for the next action's type.
+// Allowed values are "redirect_to_url", "use_stripe_sdk".
+type PaymentIntentNextActionType string
+
+// PaymentIntentCancellationReason is the list of allowed values for the cancellation reason.
+// Allowed values are "duplicate", "fraudulent", "requested_by_customer", "abandoned".
+type PaymentIntentCancellationReason string
+
+// PaymentIntentParams is the set of parameters that can be used when creating or updating a payment intent.
+// For more details see https://stripe.com/docs/api#create_payment_intent and https://stripe.com/docs/api#update_payment_intent.
+type PaymentIntentParams struct {
+	Params
+	Amount             uint64                          `form:"amount"`
+	Currency           Currency                        `form:"currency"`
+	Customer           string                          `form:"customer"`
+	Desc               string                          `form:"description"`
+	Email              string                          `form:"receipt_email"`
+	Statement          string                          `form:"statement_descriptor"`
+	Fee                uint64                          `form:"application_fee_amount"`
+	OnBehalfOf         string                          `form:"on_behalf_of"`
+	PaymentMethod      string                          `form:"payment_method"`
+	PaymentMethodTypes []string                        `form:"payment_method_types"`
+	Source             string                          `form:"source"`
+	CaptureMethod      PaymentIntentCaptureMethod      `form:"capture_method"`
+	ConfirmationMethod PaymentIntentConfirmationMethod `form:"confirmation_method"`
+	Confirm            bool                            `form:"confirm"`
+	ReturnURL          string                          `form:"return_url"`
+	SetupFutureUsage   string                          `form:"setup_future_usage"`
+	TransferDest       string                          `form:"transfer_data[destination]"`
+}
+
+// Validate checks the payment intent parameters before they are sent.
+func (p *PaymentIntentParams) Validate() error {
+	v := &validation{}
+	p.Params.validate(v)
+	v.statement("statement_descriptor", p.Statement, maxStatementLen)
+	v.oneOf("capture_method", string(p.CaptureMethod), "automatic", "manual")
+	v.oneOf("confirmation_method", string(p.ConfirmationMethod), "automatic", "manual")
+	v.oneOf("setup_future_usage", p.SetupFutureUsage, "on_session", "off_session")
+
+	// amount and currency are only sent when creating a payment intent
+	if p.Amount > 0 || len(p.Currency) > 0 {
+		if p.Amount == 0 {
+			v.add("amount", "is required")
+		}
+
+		v.required("currency", string(p.Currency))
+
+		if p.Fee > p.Amount {
+			v.add("application_fee_amount", "must not be greater than amount")
+		}
+	}
+
+	if len(p.ReturnURL) > 0 && !p.Confirm {
+		v.add("return_url", "can only be set when confirm is set")
+	}
+
+	return v.err()
+}
+
+// PaymentIntentConfirmParams is the set of parameters that can be used when confirming a payment intent.
+// For more details see https://stripe.com/docs/api#confirm_payment_intent.
+type PaymentIntentConfirmParams struct {
+	Params
+	Email         string `form:"receipt_email"`
+	PaymentMethod string `form:"payment_method"`
+	Source        string `form:"source"`
+	ReturnURL     string `form:"return_url"`
+	OffSession    bool   `form:"off_session"`
+}
+
+// Validate checks the payment intent confirmation parameters before they are sent.
+func (p *PaymentIntentConfirmParams) Validate() error {
+	v := &validation{}
+	p.Params.validate(v)
+	return v.err()
+}
+
+// PaymentIntentCaptureParams is the set of parameters that can be used when capturing a payment intent.
+// For more details see https://stripe.com/docs/api#capture_payment_intent.
+type PaymentIntentCaptureParams struct {
+	Params
+	Amount uint64 `form:"amount_to_capture"`
+	Fee    uint64 `form:"application_fee_amount"`
+}
+
+// Validate checks the payment intent capture parameters before they are sent.
+func (p *PaymentIntentCaptureParams) Validate() error {
+	v := &validation{}
+	p.Params.validate(v)
+
+	if p.Amount > 0 && p.Fee > p.Amount {
+		v.add("application_fee_amount", "must not be greater than amount_to_capture")
+	}
+
+	return v.err()
+}
+
+// PaymentIntentCancelParams is the set of parameters that can be used when canceling a payment intent.
+// For more details see https://stripe.com/docs/api#cancel_payment_intent.
+type PaymentIntentCancelParams struct {
+	Params
+	Reason PaymentIntentCancellationReason `form:"cancellation_reason"`
+}
+
+// Validate checks the payment intent cancellation parameters before they are sent.
+func (p *PaymentIntentCancelParams) Validate() error {
+	v := &validation{}
+	p.Params.validate(v)
+	v.oneOf("cancellation_reason", string(p.Reason), "duplicate", "fraudulent", "requested_by_customer", "abandoned")
+	return v.err()
+}
+
+// PaymentIntentListParams is the set of parameters that can be used when listing payment intents.
+// For more details see https://stripe.com/docs/api#list_payment_intents.
+type PaymentIntentListParams struct {
+	ListParams
+	Created      Timestamp    `form:"created"`
+	CreatedRange *RangeParams `form:"created"`
+	Customer     string       `form:"customer"`
+}
+
+// Validate checks the payment intent list parameters before they are sent.
+func (p *PaymentIntentListParams) Validate() error {
+	v := &validation{}
+	p.ListParams.validate(v)
+	v.timeRange("created", p.Created, p.CreatedRange)
+	return v.err()
+}
+
+// PaymentIntent is the resource representing a Stripe payment intent.
+// For more details see https://stripe.com/docs/api#payment_intents.
+type PaymentIntent struct {
+	Extra
+	ID                 string                          `json:"id"`
+	Live               bool                            `json:"livemode"`
+	Amount             uint64                          `json:"amount"`
+	AmountCapturable   uint64                          `json:"amount_capturable"`
+	AmountReceived     uint64                          `json:"amount_received"`
+	Fee                uint64                          `json:"application_fee_amount"`
+	Canceled           Timestamp                       `json:"canceled_at"`
+	CancellationReason PaymentIntentCancellationReason `json:"cancellation_reason"`
+	CaptureMethod      PaymentIntentCaptureMethod      `json:"capture_method"`
+	Charges            *ChargeList                     `json:"charges"`
+	ClientSecret       string                          `json:"client_secret"`
+	ConfirmationMethod PaymentIntentConfirmationMethod `json:"confirmation_method"`
+	Created            Timestamp                       `json:"created"`
+	Currency           Currency                        `json:"currency"`
+	Customer           *Customer                       `json:"customer"`
+	Desc               string                          `json:"description"`
+	Email              string                          `json:"receipt_email"`
+	Invoice            *Invoice                        `json:"invoice"`
+	LastPaymentError   *PaymentIntentLastPaymentError  `json:"last_payment_error"`
+	Meta               map[string]string               `json:"metadata"`
+	NextAction         *PaymentIntentNextAction        `json:"next_action"`
+	OnBehalfOf         *Account                        `json:"on_behalf_of"`
+	PaymentMethod      *PaymentMethod                  `json:"payment_method"`
+	PaymentMethodTypes []string                        `json:"payment_method_types"`
+	SetupFutureUsage   string                          `json:"setup_future_usage"`
+	Source             *PaymentSource                  `json:"source"`
+	Statement          string                          `json:"statement_descriptor"`
+	Status             PaymentIntentStatus             `json:"status"`
+	TransferData       *PaymentIntentTransferData      `json:"transfer_data"`
+	Expanded           bool                            `json:"-"`
+}
+
+// PaymentIntentNextAction is the action the customer has to take for a
+// payment intent to proceed, such as authenticating the payment.
+type PaymentIntentNextAction struct {
+	Type          PaymentIntentNextActionType           `json:"type"`
+	RedirectToURL *PaymentIntentNextActionRedirectToURL `json:"redirect_to_url"`
+	UseStripeSDK  map[string]interface{}                `json:"use_stripe_sdk"`
+}
+
+// PaymentIntentNextActionRedirectToURL is the page the customer is redirected
+// to when the next action is of type "redirect_to_url".
+type PaymentIntentNextActionRedirectToURL struct {
+	URL       string `json:"url"`
+	ReturnURL string `json:"return_url"`
+}
+
+// PaymentIntentLastPaymentError is the error of the last failed payment
+// attempt of a payment intent, such as a declined card. It is also the
+// error of the last failed setup of a setup intent.
+type PaymentIntentLastPaymentError struct {
+	Type          ErrorType      `json:"type"`
+	Msg           string         `json:"message"`
+	Code          ErrorCode      `json:"code"`
+	DeclineCode   string         `json:"decline_code"`
+	Param         string         `json:"param"`
+	Charge        string         `json:"charge"`
+	PaymentMethod *PaymentMethod `json:"payment_method"`
+}
+
+// PaymentIntentTransferData is the destination of the funds of a payment
+// intent made on behalf of a connected account.
+type PaymentIntentTransferData struct {
+	Dest *Account `json:"destination"`
+}
+
+// PaymentIntentList is a list object for payment intents.
+type PaymentIntentList struct {
+	ListMeta
+	Values []*PaymentIntent `json:"data"`
+}
+
+// UnmarshalJSON handles deserialization of a PaymentIntent.
+// This custom unmarshaling is needed because the resulting
+// property may be an id or the full struct if it was expanded.
+func (p *PaymentIntent) UnmarshalJSON(data []byte) error {
+	type paymentIntent PaymentIntent
+	return unmarshalExpandable(data, &p.ID, &p.Expanded, (*paymentIntent)(p))
+}
@@ -0,0 +1,139 @@
+package stripe
+
+// PaymentMethodType is the list of allowed values for the payment method's type.
+// Allowed values are "card", "card_present".
+type PaymentMethodType string
+
+// PaymentMethodParams is the set of parameters that can be used when creating or updating a payment method.
+// For more details see https://stripe.com/docs/api#create_payment_method and https://stripe.com/docs/api#update_payment_method.
+type PaymentMethodParams struct {
+	Params
+	Type           PaymentMethodType        `form:"type"`
+	Card           *PaymentMethodCardParams `form:"card"`
+	BillingDetails *BillingDetails          `form:"billing_details"`
+}
+
+// PaymentMethodCardParams is the card of a payment method, given either by
+// its raw details or by a token.
+type PaymentMethodCardParams struct {
+	Token  string `form:"token"`
+	Number string `form:"number"`
+	Month  string `form:"exp_month"`
+	Year   string `form:"exp_year"`
+	CVC    string `form:"cvc"`
+}
+
+// Validate checks the payment method parameters before they are sent.
+func (p *PaymentMethodParams) Validate() error {
+	v := &validation{}
+	p.Params.validate(v)
+	v.oneOf("type", string(p.Type), "card", "card_present")
+
+	if c := p.Card; c != nil {
+		if len(c.Token) > 0 {
+			if len(c.Number) > 0 {
+				v.add("card", "cannot set both a token and a card number")
+			}
+		} else if len(c.Number) > 0 || len(c.Month) > 0 || len(c.Year) > 0 {
+			v.required("card[number]", c.Number)
+			v.required("card[exp_month]", c.Month)
+			v.required("card[exp_year]", c.Year)
+		}
+	}
+
+	return v.err()
+}
+
+// PaymentMethodAttachParams is the set of parameters that can be used when attaching a payment method to a customer.
+// For more details see https://stripe.com/docs/api#attach_payment_method.
+type PaymentMethodAttachParams struct {
+	Params
+	Customer string `form:"customer"`
+}
+
+// Validate checks the payment method attach parameters before they are sent.
+func (p *PaymentMethodAttachParams) Validate() error {
+	v := &validation{}
+	p.Params.validate(v)
+	v.required("customer", p.Customer)
+	return v.err()
+}
+
+// PaymentMethodListParams is the set of parameters that can be used when listing the payment methods of a customer.
+// For more details see https://stripe.com/docs/api#list_payment_methods.
+type PaymentMethodListParams struct {
+	ListParams
+	Customer string            `form:"customer"`
+	Type     PaymentMethodType `form:"type"`
+}
+
+// Validate checks the payment method list parameters before they are sent.
+func (p *PaymentMethodListParams) Validate() error {
+	v := &validation{}
+	p.ListParams.validate(v)
+	v.required("customer", p.Customer)
+	v.required("type", string(p.Type))
+	return v.err()
+}
+
+// BillingDetails is the billing information of a payment method.
+type BillingDetails struct {
+	Address *Address `json:"address" form:"address"`
+	Email   string   `json:"email" form:"email"`
+	Name    string   `json:"name" form:"name"`
+	Phone   string   `json:"phone" form:"phone"`
+}
+
+// PaymentMethod is the resource representing a Stripe payment method.
+// For more details see https://stripe.com/docs/api#payment_methods.
+type PaymentMethod struct {
+	Extra
+	ID             string             `json:"id"`
+	Live           bool               `json:"livemode"`
+	Type           PaymentMethodType  `json:"type"`
+	BillingDetails *BillingDetails    `json:"billing_details"`
+	Card           *PaymentMethodCard `json:"card"`
+	Created        Timestamp          `json:"created"`
+	Customer       *Customer          `json:"customer"`
+	Meta           map[string]string  `json:"metadata"`
+	Expanded       bool               `json:"-"`
+}
+
+// PaymentMethodCard is the card of a payment method of type "card".
+type PaymentMethodCard struct {
+	Brand             string                              `json:"brand"`
+	Checks            *PaymentMethodCardChecks            `json:"checks"`
+	Country           string                              `json:"country"`
+	Month             uint8                               `json:"exp_month"`
+	Year              uint16                              `json:"exp_year"`
+	Fingerprint       string                              `json:"fingerprint"`
+	Funding           CardFunding                         `json:"funding"`
+	LastFour          string                              `json:"last4"`
+	ThreeDSecureUsage *PaymentMethodCardThreeDSecureUsage `json:"three_d_secure_usage"`
+}
+
+// PaymentMethodCardChecks are the results of the checks made on the card.
+type PaymentMethodCardChecks struct {
+	Address1Check Verification `json:"address_line1_check"`
+	ZipCheck      Verification `json:"address_postal_code_check"`
+	CVCCheck      Verification `json:"cvc_check"`
+}
+
+// PaymentMethodCardThreeDSecureUsage tells whether 3D Secure is supported by the card.
+type PaymentMethodCardThreeDSecureUsage struct {
+	Supported bool `json:"supported"`
+}
+
+// PaymentMethodList is a list object for payment methods.
+type PaymentMethodList struct {
+	ListMeta
+	Values []*PaymentMethod `json:"data"`
+}
+
+// UnmarshalJSON handles deserialization of a PaymentMethod.
+// This custom unmarshaling is needed because the resulting
+// property may be an id or the full struct if it was expanded.
+func (p *PaymentMethod) UnmarshalJSON(data []byte) error {
+	type paymentMethod PaymentMethod
+	return unmarshalExpandable(data, &p.ID, &p.Expanded, (*paymentMethod)(p))
+}
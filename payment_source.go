@@ -5,23 +5,40 @@ import (
 	"errors"
 	"fmt"
 	"net/url"
+
+	"github.com/channelmeter/stripe-go/form"
 )
 
 // SourceParams is a union struct used to describe an
 // arbitrary payment source.
 type SourceParams struct {
-	Token string
-	Card  *CardParams
+	Token string      `form:"-"`
+	Card  *CardParams `form:"-"`
+}
+
+// Validate checks that exactly one kind of source is set.
+func (sp *SourceParams) Validate() error {
+	v := &validation{}
+
+	if len(sp.Token) > 0 && sp.Card != nil {
+		v.add("source", "cannot set both a token and a card")
+	}
+
+	if sp.Card != nil {
+		v.nested(sp.Card.Validate())
+	}
+
+	return v.err()
 }
 
-// AppendDetails adds the source's details to the query string values.
-// For cards: when creating a new one, the parameters are passed as a dictionary, but
-// on updates they are simply the parameter name.
-func (sp *SourceParams) AppendDetails(values *url.Values, creating bool) {
+// AppendForm implements form.Appender for payment sources. A token is sent
+// as the value of keyParts, while a card is always passed as a card
+// dictionary.
+func (sp *SourceParams) AppendForm(values *url.Values, keyParts []string) {
 	if len(sp.Token) > 0 {
-		values.Add("source", sp.Token)
+		values.Add(form.FormatKey(keyParts), sp.Token)
 	} else if sp.Card != nil {
-		sp.Card.AppendDetails(values, creating)
+		sp.Card.AppendForm(values, []string{"card"})
 	}
 }
 
@@ -30,8 +47,21 @@ func (sp *SourceParams) AppendDetails(values *url.Values, creating bool) {
 // For more details see https://stripe.com/docs/api#sources
 type CustomerSourceParams struct {
 	Params
-	Customer string
-	Source   *SourceParams
+	Customer string        `form:"-"`
+	Source   *SourceParams `form:"source"`
+}
+
+// Validate checks the customer source parameters before they are sent.
+func (cp *CustomerSourceParams) Validate() error {
+	v := &validation{}
+	cp.Params.validate(v)
+	v.required("customer", cp.Customer)
+
+	if cp.Source != nil {
+		v.nested(cp.Source.Validate())
+	}
+
+	return v.err()
 }
 
 // SetSource adds valid sources to a CustomerSourceParams object,
@@ -84,11 +114,13 @@ const (
 // The Type should indicate which object is fleshed out (eg. BitcoinReceiver or Card)
 // For more details see https://stripe.com/docs/api#retrieve_charge
 type PaymentSource struct {
+	Extra
 	Type            PaymentSourceType `json:"object"`
 	ID              string            `json:"id"`
 	Card            *Card             `json:"-"`
 	BitcoinReceiver *BitcoinReceiver  `json:"-"`
 	BankAccount     *BankAccount      `json:"-"`
+	Expanded        bool              `json:"-"`
 }
 
 // SourceList is a list object for cards.
@@ -104,6 +136,14 @@ type SourceListParams struct {
 	Customer string
 }
 
+// Validate checks the source list parameters before they are sent.
+func (p *SourceListParams) Validate() error {
+	v := &validation{}
+	p.ListParams.validate(v)
+	v.required("customer", p.Customer)
+	return v.err()
+}
+
 // Display human readable representation of source.
 func (s *PaymentSource) Display() string {
 	switch s.Type {
@@ -121,25 +161,34 @@ func (s *PaymentSource) Display() string {
 // type of payment instrument it refers to is specified in the JSON
 func (s *PaymentSource) UnmarshalJSON(data []byte) error {
 	type source PaymentSource
-	var ss source
-	err := json.Unmarshal(data, &ss)
-	if err == nil {
-		*s = PaymentSource(ss)
-
-		switch s.Type {
-		case PaymentSourceBitcoinReceiver:
-			json.Unmarshal(data, &s.BitcoinReceiver)
-		case PaymentSourceCard:
-			json.Unmarshal(data, &s.Card)
-		case PaymentSourceBank:
-			json.Unmarshal(data, &s.BankAccount)
+	if len(data) > 0 && data[0] == '{' {
+		// the fields of a source are those of its specific type, so they
+		// are only checked when decoding it below
+		if err := decodeResource(data, (*source)(s), false); err != nil {
+			return err
 		}
-	} else {
-		// the id is surrounded by "\" characters, so strip them
-		s.ID = string(data[1 : len(data)-1])
+		s.Expanded = true
+	} else if err := unmarshalExpandable(data, &s.ID, &s.Expanded, (*source)(s)); err != nil {
+		return err
 	}
 
-	return nil
+	var err error
+	switch s.Type {
+	case PaymentSourceBitcoinReceiver:
+		if err = json.Unmarshal(data, &s.BitcoinReceiver); err == nil {
+			s.Extra = s.BitcoinReceiver.Extra
+		}
+	case PaymentSourceCard:
+		if err = json.Unmarshal(data, &s.Card); err == nil {
+			s.Extra = s.Card.Extra
+		}
+	case PaymentSourceBank:
+		if err = json.Unmarshal(data, &s.BankAccount); err == nil {
+			s.Extra = s.BankAccount.Extra
+		}
+	}
+
+	return err
 }
 
 // MarshalJSON handles serialization of a PaymentSource.
@@ -0,0 +1,239 @@
+// Package paymentintent provides the /payment_intents APIs
+package paymentintent
+
+import (
+	"fmt"
+	"net/url"
+
+	stripe "github.com/channelmeter/stripe-go"
+	"github.com/channelmeter/stripe-go/form"
+)
+
+const (
+	RequiresPaymentMethod stripe.PaymentIntentStatus = "requires_payment_method"
+	RequiresConfirmation  stripe.PaymentIntentStatus = "requires_confirmation"
+	RequiresAction        stripe.PaymentIntentStatus = "requires_action"
+	Processing            stripe.PaymentIntentStatus = "processing"
+	RequiresCapture       stripe.PaymentIntentStatus = "requires_capture"
+	Canceled              stripe.PaymentIntentStatus = "canceled"
+	Succeeded             stripe.PaymentIntentStatus = "succeeded"
+
+	CaptureAutomatic stripe.PaymentIntentCaptureMethod = "automatic"
+	CaptureManual    stripe.PaymentIntentCaptureMethod = "manual"
+
+	ConfirmationAutomatic stripe.PaymentIntentConfirmationMethod = "automatic"
+	ConfirmationManual    stripe.PaymentIntentConfirmationMethod = "manual"
+
+	RedirectToURL stripe.PaymentIntentNextActionType = "redirect_to_url"
+	UseStripeSDK  stripe.PaymentIntentNextActionType = "use_stripe_sdk"
+
+	CancelDuplicate           stripe.PaymentIntentCancellationReason = "duplicate"
+	CancelFraudulent          stripe.PaymentIntentCancellationReason = "fraudulent"
+	CancelRequestedByCustomer stripe.PaymentIntentCancellationReason = "requested_by_customer"
+	CancelAbandoned           stripe.PaymentIntentCancellationReason = "abandoned"
+)
+
+// Client is used to invoke /payment_intents APIs.
+type Client struct {
+	B   stripe.Backend
+	Key string
+	// NoValidate disables the client-side validation of params
+	// that is otherwise done before calling the backend.
+	NoValidate bool
+}
+
+// New POSTs a new payment intent.
+// For more details see https://stripe.com/docs/api#create_payment_intent.
+func New(params *stripe.PaymentIntentParams) (*stripe.PaymentIntent, error) {
+	return getC().New(params)
+}
+
+func (c Client) New(params *stripe.PaymentIntentParams) (*stripe.PaymentIntent, error) {
+	if err := stripe.ValidateParams(params, c.NoValidate); err != nil {
+		return nil, err
+	}
+
+	body := &url.Values{}
+	form.AppendTo(body, params)
+
+	intent := &stripe.PaymentIntent{}
+	err := c.B.Call("POST", "/payment_intents", c.Key, body, stripe.CurrentVersion(&params.Params), intent)
+
+	return intent, err
+}
+
+// Get returns the details of a payment intent.
+// For more details see https://stripe.com/docs/api#retrieve_payment_intent.
+func Get(id string, params *stripe.PaymentIntentParams) (*stripe.PaymentIntent, error) {
+	return getC().Get(id, params)
+}
+
+func (c Client) Get(id string, params *stripe.PaymentIntentParams) (*stripe.PaymentIntent, error) {
+	var body *url.Values
+	var commonParams *stripe.Params
+
+	if params != nil {
+		commonParams = &params.Params
+		body = &url.Values{}
+		params.AppendTo(body)
+	}
+
+	intent := &stripe.PaymentIntent{}
+	err := c.B.Call("GET", "/payment_intents/"+id, c.Key, body, stripe.CurrentVersion(commonParams), intent)
+
+	return intent, err
+}
+
+// Update updates a payment intent's properties.
+// For more details see https://stripe.com/docs/api#update_payment_intent.
+func Update(id string, params *stripe.PaymentIntentParams) (*stripe.PaymentIntent, error) {
+	return getC().Update(id, params)
+}
+
+func (c Client) Update(id string, params *stripe.PaymentIntentParams) (*stripe.PaymentIntent, error) {
+	if err := stripe.ValidateParams(params, c.NoValidate); err != nil {
+		return nil, err
+	}
+
+	var body *url.Values
+	var commonParams *stripe.Params
+
+	if params != nil {
+		commonParams = &params.Params
+		body = &url.Values{}
+		form.AppendTo(body, params)
+	}
+
+	intent := &stripe.PaymentIntent{}
+	err := c.B.Call("POST", "/payment_intents/"+id, c.Key, body, stripe.CurrentVersion(commonParams), intent)
+
+	return intent, err
+}
+
+// Confirm confirms that the customer intends to pay with the payment intent.
+// For more details see https://stripe.com/docs/api#confirm_payment_intent.
+func Confirm(id string, params *stripe.PaymentIntentConfirmParams) (*stripe.PaymentIntent, error) {
+	return getC().Confirm(id, params)
+}
+
+func (c Client) Confirm(id string, params *stripe.PaymentIntentConfirmParams) (*stripe.PaymentIntent, error) {
+	if err := stripe.ValidateParams(params, c.NoValidate); err != nil {
+		return nil, err
+	}
+
+	var body *url.Values
+	var commonParams *stripe.Params
+
+	if params != nil {
+		commonParams = &params.Params
+		body = &url.Values{}
+		form.AppendTo(body, params)
+	}
+
+	intent := &stripe.PaymentIntent{}
+	err := c.B.Call("POST", fmt.Sprintf("/payment_intents/%v/confirm", id), c.Key, body, stripe.CurrentVersion(commonParams), intent)
+
+	return intent, err
+}
+
+// Capture captures the funds of a payment intent whose status is requires_capture.
+// For more details see https://stripe.com/docs/api#capture_payment_intent.
+func Capture(id string, params *stripe.PaymentIntentCaptureParams) (*stripe.PaymentIntent, error) {
+	return getC().Capture(id, params)
+}
+
+func (c Client) Capture(id string, params *stripe.PaymentIntentCaptureParams) (*stripe.PaymentIntent, error) {
+	if err := stripe.ValidateParams(params, c.NoValidate); err != nil {
+		return nil, err
+	}
+
+	var body *url.Values
+	var commonParams *stripe.Params
+
+	if params != nil {
+		commonParams = &params.Params
+		body = &url.Values{}
+		form.AppendTo(body, params)
+	}
+
+	intent := &stripe.PaymentIntent{}
+	err := c.B.Call("POST", fmt.Sprintf("/payment_intents/%v/capture", id), c.Key, body, stripe.CurrentVersion(commonParams), intent)
+
+	return intent, err
+}
+
+// Cancel cancels a payment intent.
+// For more details see https://stripe.com/docs/api#cancel_payment_intent.
+func Cancel(id string, params *stripe.PaymentIntentCancelParams) (*stripe.PaymentIntent, error) {
+	return getC().Cancel(id, params)
+}
+
+func (c Client) Cancel(id string, params *stripe.PaymentIntentCancelParams) (*stripe.PaymentIntent, error) {
+	if err := stripe.ValidateParams(params, c.NoValidate); err != nil {
+		return nil, err
+	}
+
+	var body *url.Values
+	var commonParams *stripe.Params
+
+	if params != nil {
+		commonParams = &params.Params
+		body = &url.Values{}
+		form.AppendTo(body, params)
+	}
+
+	intent := &stripe.PaymentIntent{}
+	err := c.B.Call("POST", fmt.Sprintf("/payment_intents/%v/cancel", id), c.Key, body, stripe.CurrentVersion(commonParams), intent)
+
+	return intent, err
+}
+
+// List returns a list of payment intents.
+// For more details see https://stripe.com/docs/api#list_payment_intents.
+func List(params *stripe.PaymentIntentListParams) *Iter {
+	return getC().List(params)
+}
+
+func (c Client) List(params *stripe.PaymentIntentListParams) *Iter {
+	if err := stripe.ValidateParams(params, c.NoValidate); err != nil {
+		return &Iter{stripe.GetIterErr(err)}
+	}
+
+	var body *url.Values
+	var lp *stripe.ListParams
+
+	if params != nil {
+		body = &url.Values{}
+		form.AppendTo(body, params)
+		lp = &params.ListParams
+	}
+
+	return &Iter{stripe.GetIter(lp, body, func(b url.Values) ([]interface{}, stripe.ListMeta, error) {
+		list := &stripe.PaymentIntentList{}
+		err := c.B.Call("GET", "/payment_intents", c.Key, &b, stripe.CurrentVersion(nil), list)
+
+		ret := make([]interface{}, len(list.Values))
+		for i, v := range list.Values {
+			ret[i] = v
+		}
+
+		return ret, list.ListMeta, err
+	})}
+}
+
+// Iter is an iterator for lists of PaymentIntents.
+// The embedded Iter carries methods with it;
+// see its documentation for details.
+type Iter struct {
+	*stripe.Iter
+}
+
+// PaymentIntent returns the most recent PaymentIntent
+// visited by a call to Next.
+func (i *Iter) PaymentIntent() *stripe.PaymentIntent {
+	return i.Current().(*stripe.PaymentIntent)
+}
+
+func getC() Client {
+	return Client{B: stripe.GetBackend(stripe.APIBackend), Key: stripe.Key}
+}
@@ -0,0 +1,129 @@
+package paymentintent
+
+import (
+	"testing"
+
+	stripe "github.com/channelmeter/stripe-go"
+	"github.com/channelmeter/stripe-go/currency"
+	. "github.com/channelmeter/stripe-go/utils"
+)
+
+func init() {
+	stripe.Key = GetTestKey()
+}
+
+func TestPaymentIntentNew(t *testing.T) {
+	params := &stripe.PaymentIntentParams{
+		Amount:             1000,
+		Currency:           currency.USD,
+		PaymentMethodTypes: []string{"card"},
+		Desc:               "Payment intent test",
+	}
+
+	target, err := New(params)
+
+	if err != nil {
+		t.Error(err)
+	}
+
+	if target.Amount != params.Amount {
+		t.Errorf("Amount %v does not match expected amount %v\n", target.Amount, params.Amount)
+	}
+
+	if target.Currency != params.Currency {
+		t.Errorf("Currency %q does not match expected currency %q\n", target.Currency, params.Currency)
+	}
+
+	if target.Status != RequiresPaymentMethod {
+		t.Errorf("Status %q does not match expected status %q\n", target.Status, RequiresPaymentMethod)
+	}
+
+	if len(target.ClientSecret) == 0 {
+		t.Errorf("Client secret is not set\n")
+	}
+}
+
+func TestPaymentIntentConfirmCapture(t *testing.T) {
+	intent, _ := New(&stripe.PaymentIntentParams{
+		Amount:             1000,
+		Currency:           currency.USD,
+		PaymentMethodTypes: []string{"card"},
+		CaptureMethod:      CaptureManual,
+	})
+
+	target, err := Confirm(intent.ID, &stripe.PaymentIntentConfirmParams{PaymentMethod: "pm_card_visa"})
+
+	if err != nil {
+		t.Error(err)
+	}
+
+	if target.Status != RequiresCapture {
+		t.Errorf("Status %q does not match expected status %q\n", target.Status, RequiresCapture)
+	}
+
+	target, err = Capture(intent.ID, &stripe.PaymentIntentCaptureParams{Amount: 800})
+
+	if err != nil {
+		t.Error(err)
+	}
+
+	if target.Status != Succeeded {
+		t.Errorf("Status %q does not match expected status %q\n", target.Status, Succeeded)
+	}
+
+	if target.AmountReceived != 800 {
+		t.Errorf("Amount received %v does not match expected amount 800\n", target.AmountReceived)
+	}
+}
+
+func TestPaymentIntentUpdateCancel(t *testing.T) {
+	intent, _ := New(&stripe.PaymentIntentParams{
+		Amount:             1000,
+		Currency:           currency.USD,
+		PaymentMethodTypes: []string{"card"},
+	})
+
+	target, err := Update(intent.ID, &stripe.PaymentIntentParams{Desc: "Updated"})
+
+	if err != nil {
+		t.Error(err)
+	}
+
+	if target.Desc != "Updated" {
+		t.Errorf("Description %q does not match expected description \"Updated\"\n", target.Desc)
+	}
+
+	target, err = Cancel(intent.ID, &stripe.PaymentIntentCancelParams{Reason: CancelRequestedByCustomer})
+
+	if err != nil {
+		t.Error(err)
+	}
+
+	if target.Status != Canceled {
+		t.Errorf("Status %q does not match expected status %q\n", target.Status, Canceled)
+	}
+
+	if target.CancellationReason != CancelRequestedByCustomer {
+		t.Errorf("Cancellation reason %q does not match expected reason %q\n", target.CancellationReason, CancelRequestedByCustomer)
+	}
+}
+
+func TestPaymentIntentList(t *testing.T) {
+	params := &stripe.PaymentIntentListParams{}
+	params.Filters.AddFilter("limit", "", "5")
+	params.Single = true
+
+	i := List(params)
+	for i.Next() {
+		if i.PaymentIntent() == nil {
+			t.Error("No nil values expected")
+		}
+
+		if i.Meta() == nil {
+			t.Error("No metadata returned")
+		}
+	}
+	if err := i.Err(); err != nil {
+		t.Error(err)
+	}
+}
@@ -0,0 +1,169 @@
+// Package paymentmethod provides the /payment_methods APIs
+package paymentmethod
+
+import (
+	"fmt"
+	"net/url"
+
+	stripe "github.com/channelmeter/stripe-go"
+	"github.com/channelmeter/stripe-go/form"
+)
+
+const (
+	Card        stripe.PaymentMethodType = "card"
+	CardPresent stripe.PaymentMethodType = "card_present"
+)
+
+// Client is used to invoke /payment_methods APIs.
+type Client struct {
+	B   stripe.Backend
+	Key string
+	// NoValidate disables the client-side validation of params
+	// that is otherwise done before calling the backend.
+	NoValidate bool
+}
+
+// New POSTs a new payment method.
+// For more details see https://stripe.com/docs/api#create_payment_method.
+func New(params *stripe.PaymentMethodParams) (*stripe.PaymentMethod, error) {
+	return getC().New(params)
+}
+
+func (c Client) New(params *stripe.PaymentMethodParams) (*stripe.PaymentMethod, error) {
+	if err := stripe.ValidateParams(params, c.NoValidate); err != nil {
+		return nil, err
+	}
+
+	body := &url.Values{}
+	form.AppendTo(body, params)
+
+	pm := &stripe.PaymentMethod{}
+	err := c.B.Call("POST", "/payment_methods", c.Key, body, stripe.CurrentVersion(&params.Params), pm)
+
+	return pm, err
+}
+
+// Get returns the details of a payment method.
+// For more details see https://stripe.com/docs/api#retrieve_payment_method.
+func Get(id string, params *stripe.PaymentMethodParams) (*stripe.PaymentMethod, error) {
+	return getC().Get(id, params)
+}
+
+func (c Client) Get(id string, params *stripe.PaymentMethodParams) (*stripe.PaymentMethod, error) {
+	var body *url.Values
+	var commonParams *stripe.Params
+
+	if params != nil {
+		commonParams = &params.Params
+		body = &url.Values{}
+		params.AppendTo(body)
+	}
+
+	pm := &stripe.PaymentMethod{}
+	err := c.B.Call("GET", "/payment_methods/"+id, c.Key, body, stripe.CurrentVersion(commonParams), pm)
+
+	return pm, err
+}
+
+// Update updates a payment method's properties.
+// For more details see https://stripe.com/docs/api#update_payment_method.
+func Update(id string, params *stripe.PaymentMethodParams) (*stripe.PaymentMethod, error) {
+	return getC().Update(id, params)
+}
+
+func (c Client) Update(id string, params *stripe.PaymentMethodParams) (*stripe.PaymentMethod, error) {
+	if err := stripe.ValidateParams(params, c.NoValidate); err != nil {
+		return nil, err
+	}
+
+	var body *url.Values
+	var commonParams *stripe.Params
+
+	if params != nil {
+		commonParams = &params.Params
+		body = &url.Values{}
+		form.AppendTo(body, params)
+	}
+
+	pm := &stripe.PaymentMethod{}
+	err := c.B.Call("POST", "/payment_methods/"+id, c.Key, body, stripe.CurrentVersion(commonParams), pm)
+
+	return pm, err
+}
+
+// Attach attaches a payment method to a customer.
+// For more details see https://stripe.com/docs/api#attach_payment_method.
+func Attach(id string, params *stripe.PaymentMethodAttachParams) (*stripe.PaymentMethod, error) {
+	return getC().Attach(id, params)
+}
+
+func (c Client) Attach(id string, params *stripe.PaymentMethodAttachParams) (*stripe.PaymentMethod, error) {
+	if err := stripe.ValidateParams(params, c.NoValidate); err != nil {
+		return nil, err
+	}
+
+	body := &url.Values{}
+	form.AppendTo(body, params)
+
+	pm := &stripe.PaymentMethod{}
+	err := c.B.Call("POST", fmt.Sprintf("/payment_methods/%v/attach", id), c.Key, body, stripe.CurrentVersion(&params.Params), pm)
+
+	return pm, err
+}
+
+// Detach detaches a payment method from its customer.
+// For more details see https://stripe.com/docs/api#detach_payment_method.
+func Detach(id string) (*stripe.PaymentMethod, error) {
+	return getC().Detach(id)
+}
+
+func (c Client) Detach(id string) (*stripe.PaymentMethod, error) {
+	pm := &stripe.PaymentMethod{}
+	err := c.B.Call("POST", fmt.Sprintf("/payment_methods/%v/detach", id), c.Key, nil, stripe.CurrentVersion(nil), pm)
+
+	return pm, err
+}
+
+// List returns a list of the payment methods of a customer.
+// For more details see https://stripe.com/docs/api#list_payment_methods.
+func List(params *stripe.PaymentMethodListParams) *Iter {
+	return getC().List(params)
+}
+
+func (c Client) List(params *stripe.PaymentMethodListParams) *Iter {
+	if err := stripe.ValidateParams(params, c.NoValidate); err != nil {
+		return &Iter{stripe.GetIterErr(err)}
+	}
+
+	body := &url.Values{}
+	form.AppendTo(body, params)
+
+	return &Iter{stripe.GetIter(&params.ListParams, body, func(b url.Values) ([]interface{}, stripe.ListMeta, error) {
+		list := &stripe.PaymentMethodList{}
+		err := c.B.Call("GET", "/payment_methods", c.Key, &b, stripe.CurrentVersion(nil), list)
+
+		ret := make([]interface{}, len(list.Values))
+		for i, v := range list.Values {
+			ret[i] = v
+		}
+
+		return ret, list.ListMeta, err
+	})}
+}
+
+// Iter is an iterator for lists of PaymentMethods.
+// The embedded Iter carries methods with it;
+// see its documentation for details.
+type Iter struct {
+	*stripe.Iter
+}
+
+// PaymentMethod returns the most recent PaymentMethod
+// visited by a call to Next.
+func (i *Iter) PaymentMethod() *stripe.PaymentMethod {
+	return i.Current().(*stripe.PaymentMethod)
+}
+
+func getC() Client {
+	return Client{B: stripe.GetBackend(stripe.APIBackend), Key: stripe.Key}
+}
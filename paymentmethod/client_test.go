@@ -0,0 +1,87 @@
+package paymentmethod
+
+import (
+	"testing"
+
+	stripe "github.com/channelmeter/stripe-go"
+	"github.com/channelmeter/stripe-go/customer"
+	. "github.com/channelmeter/stripe-go/utils"
+)
+
+func init() {
+	stripe.Key = GetTestKey()
+}
+
+func TestPaymentMethodNew(t *testing.T) {
+	params := &stripe.PaymentMethodParams{
+		Type: Card,
+		Card: &stripe.PaymentMethodCardParams{
+			Number: "4242424242424242",
+			Month:  "10",
+			Year:   "30",
+		},
+		BillingDetails: &stripe.BillingDetails{Name: "Stripe Tester"},
+	}
+
+	target, err := New(params)
+
+	if err != nil {
+		t.Error(err)
+	}
+
+	if target.Type != Card {
+		t.Errorf("Type %q does not match expected type %q\n", target.Type, Card)
+	}
+
+	if target.Card == nil || target.Card.LastFour != "4242" {
+		t.Errorf("Card %+v does not match expected last four 4242\n", target.Card)
+	}
+
+	if target.BillingDetails == nil || target.BillingDetails.Name != params.BillingDetails.Name {
+		t.Errorf("Billing details %+v do not match expected name %q\n", target.BillingDetails, params.BillingDetails.Name)
+	}
+}
+
+func TestPaymentMethodAttachDetach(t *testing.T) {
+	cust, _ := customer.New(nil)
+
+	target, err := Attach("pm_card_visa", &stripe.PaymentMethodAttachParams{Customer: cust.ID})
+
+	if err != nil {
+		t.Error(err)
+	}
+
+	if target.Customer == nil || target.Customer.ID != cust.ID {
+		t.Errorf("Customer %+v does not match expected customer %q\n", target.Customer, cust.ID)
+	}
+
+	params := &stripe.PaymentMethodListParams{Customer: cust.ID, Type: Card}
+
+	i := List(params)
+	count := 0
+	for i.Next() {
+		if i.PaymentMethod().ID != target.ID {
+			t.Errorf("Payment method %q does not match expected %q\n", i.PaymentMethod().ID, target.ID)
+		}
+		count++
+	}
+	if err := i.Err(); err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Errorf("Expected one payment method but got %v\n", count)
+	}
+
+	target, err = Detach(target.ID)
+
+	if err != nil {
+		t.Error(err)
+	}
+
+	if target.Customer != nil {
+		t.Errorf("Customer %+v is still attached\n", target.Customer)
+	}
+
+	customer.Del(cust.ID)
+}
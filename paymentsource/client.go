@@ -7,12 +7,16 @@ import (
 	"net/url"
 
 	stripe "github.com/channelmeter/stripe-go"
+	"github.com/channelmeter/stripe-go/form"
 )
 
 // Client is used to invoke /sources APIs.
 type Client struct {
 	B   stripe.Backend
 	Key string
+	// NoValidate disables the client-side validation of params
+	// that is otherwise done before calling the backend.
+	NoValidate bool
 }
 
 // New POSTs new sources for a customer.
@@ -22,9 +26,12 @@ func New(params *stripe.CustomerSourceParams) (*stripe.PaymentSource, error) {
 }
 
 func (s Client) New(params *stripe.CustomerSourceParams) (*stripe.PaymentSource, error) {
+	if err := stripe.ValidateParams(params, s.NoValidate); err != nil {
+		return nil, err
+	}
+
 	body := &url.Values{}
-	params.Source.AppendDetails(body, true)
-	params.AppendTo(body)
+	form.AppendTo(body, params)
 
 	source := &stripe.PaymentSource{}
 	var err error
@@ -73,8 +80,17 @@ func Update(id string, params *stripe.CustomerSourceParams) (*stripe.PaymentSour
 }
 
 func (s Client) Update(id string, params *stripe.CustomerSourceParams) (*stripe.PaymentSource, error) {
+	if err := stripe.ValidateParams(params, s.NoValidate); err != nil {
+		return nil, err
+	}
+
 	body := &url.Values{}
-	params.Source.AppendDetails(body, false)
+
+	// card updates are sent as plain parameters rather than a dictionary
+	if params.Source != nil && params.Source.Card != nil {
+		form.AppendTo(body, params.Source.Card)
+	}
+
 	params.AppendTo(body)
 
 	source := &stripe.PaymentSource{}
@@ -111,18 +127,47 @@ func List(params *stripe.SourceListParams) *Iter {
 }
 
 func (s Client) List(params *stripe.SourceListParams) *Iter {
+	if err := stripe.ValidateParams(params, s.NoValidate); err != nil {
+		return &Iter{stripe.GetIterErr(err)}
+	}
+
 	body := &url.Values{}
 	var lp *stripe.ListParams
 
-	params.AppendTo(body)
+	form.AppendTo(body, params)
 	lp = &params.ListParams
 
-	return &Iter{stripe.GetIter(lp, body, func(b url.Values) ([]interface{}, stripe.ListMeta, error) {
+	return &Iter{stripe.GetIter(lp, body, s.query(params.Customer))}
+}
+
+// ListFrom returns all the sources of a customer, starting with the ones
+// embedded in it and fetching the remaining pages as needed.
+func ListFrom(cust *stripe.Customer) *Iter {
+	return getC().ListFrom(cust)
+}
+
+func (s Client) ListFrom(cust *stripe.Customer) *Iter {
+	list := cust.Sources
+	if list == nil {
+		list = &stripe.SourceList{ListMeta: stripe.ListMeta{More: true}}
+	}
+
+	values := make([]interface{}, len(list.Values))
+	for i, v := range list.Values {
+		values[i] = v
+	}
+
+	return &Iter{stripe.GetIterFrom(nil, nil, values, list.ListMeta, s.query(cust.ID))}
+}
+
+// query returns the query fetching a page of sources of a customer.
+func (s Client) query(customerID string) stripe.Query {
+	return func(b url.Values) ([]interface{}, stripe.ListMeta, error) {
 		list := &stripe.SourceList{}
 		var err error
 
-		if len(params.Customer) > 0 {
-			err = s.B.Call("GET", fmt.Sprintf("/customers/%v/sources", params.Customer), s.Key, &b, nil, list)
+		if len(customerID) > 0 {
+			err = s.B.Call("GET", fmt.Sprintf("/customers/%v/sources", customerID), s.Key, &b, nil, list)
 		} else {
 			err = errors.New("Invalid source params: customer needs to be set")
 		}
@@ -133,7 +178,7 @@ func (s Client) List(params *stripe.SourceListParams) *Iter {
 		}
 
 		return ret, list.ListMeta, err
-	})}
+	}
 }
 
 // Iter is an iterator for lists of PaymentSources.
@@ -150,5 +195,5 @@ func (i *Iter) PaymentSource() *stripe.PaymentSource {
 }
 
 func getC() Client {
-	return Client{stripe.GetBackend(stripe.APIBackend), stripe.Key}
+	return Client{B: stripe.GetBackend(stripe.APIBackend), Key: stripe.Key}
 }
@@ -0,0 +1,108 @@
+package stripe
+
+// PayoutStatus is the list of allowed values for the payout's status.
+// Allowed values are "paid", "pending", "in_transit", "canceled", "failed".
+type PayoutStatus string
+
+// PayoutType is the list of allowed values for the payout's type.
+// Allowed values are "bank_account", "card".
+type PayoutType string
+
+// PayoutMethod is the list of allowed values for how fast a payout is sent.
+// Allowed values are "standard", "instant".
+type PayoutMethod string
+
+// PayoutParams is the set of parameters that can be used when creating or updating a payout.
+// For more details see https://stripe.com/docs/api#create_payout and https://stripe.com/docs/api#update_payout.
+type PayoutParams struct {
+	Params
+	Amount     uint64       `form:"amount"`
+	Currency   Currency     `form:"currency"`
+	Desc       string       `form:"description"`
+	Dest       string       `form:"destination"`
+	Method     PayoutMethod `form:"method"`
+	SourceType string       `form:"source_type"`
+	Statement  string       `form:"statement_descriptor"`
+}
+
+// Validate checks the payout parameters before they are sent.
+func (p *PayoutParams) Validate() error {
+	v := &validation{}
+	p.Params.validate(v)
+	v.statement("statement_descriptor", p.Statement, maxStatementLen)
+	v.oneOf("method", string(p.Method), "standard", "instant")
+	v.oneOf("source_type", p.SourceType, "card", "bank_account")
+
+	// amount and currency are only sent when creating a payout
+	if p.Amount > 0 || len(p.Currency) > 0 {
+		if p.Amount == 0 {
+			v.add("amount", "is required")
+		}
+
+		v.required("currency", string(p.Currency))
+	}
+
+	return v.err()
+}
+
+// PayoutListParams is the set of parameters that can be used when listing payouts.
+// For more details see https://stripe.com/docs/api#list_payouts.
+type PayoutListParams struct {
+	ListParams
+	Arrival      Timestamp    `form:"arrival_date"`
+	ArrivalRange *RangeParams `form:"arrival_date"`
+	Created      Timestamp    `form:"created"`
+	CreatedRange *RangeParams `form:"created"`
+	Dest         string       `form:"destination"`
+	Status       PayoutStatus `form:"status"`
+}
+
+// Validate checks the payout list parameters before they are sent.
+func (p *PayoutListParams) Validate() error {
+	v := &validation{}
+	p.ListParams.validate(v)
+	v.timeRange("arrival_date", p.Arrival, p.ArrivalRange)
+	v.timeRange("created", p.Created, p.CreatedRange)
+	v.oneOf("status", string(p.Status), "paid", "pending", "in_transit", "canceled", "failed")
+	return v.err()
+}
+
+// Payout is the resource representing a Stripe payout.
+// For more details see https://stripe.com/docs/api#payouts.
+type Payout struct {
+	Extra
+	ID         string            `json:"id"`
+	Live       bool              `json:"livemode"`
+	Amount     uint64            `json:"amount"`
+	Arrival    Timestamp         `json:"arrival_date"`
+	Automatic  bool              `json:"automatic"`
+	Tx         *Transaction      `json:"balance_transaction"`
+	Created    Timestamp         `json:"created"`
+	Currency   Currency          `json:"currency"`
+	Desc       string            `json:"description"`
+	Dest       *PaymentSource    `json:"destination"`
+	FailTx     *Transaction      `json:"failure_balance_transaction"`
+	FailCode   TransferFailCode  `json:"failure_code"`
+	FailMsg    string            `json:"failure_message"`
+	Meta       map[string]string `json:"metadata"`
+	Method     PayoutMethod      `json:"method"`
+	SourceType string            `json:"source_type"`
+	Statement  string            `json:"statement_descriptor"`
+	Status     PayoutStatus      `json:"status"`
+	Type       PayoutType        `json:"type"`
+	Expanded   bool              `json:"-"`
+}
+
+// PayoutList is a list object for payouts.
+type PayoutList struct {
+	ListMeta
+	Values []*Payout `json:"data"`
+}
+
+// UnmarshalJSON handles deserialization of a Payout.
+// This custom unmarshaling is needed because the resulting
+// property may be an id or the full struct if it was expanded.
+func (p *Payout) UnmarshalJSON(data []byte) error {
+	type payout Payout
+	return unmarshalExpandable(data, &p.ID, &p.Expanded, (*payout)(p))
+}
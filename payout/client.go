@@ -0,0 +1,175 @@
+// Package payout provides the /payouts APIs
+package payout
+
+import (
+	"fmt"
+	"net/url"
+
+	stripe "github.com/channelmeter/stripe-go"
+	"github.com/channelmeter/stripe-go/form"
+)
+
+const (
+	Paid      stripe.PayoutStatus = "paid"
+	Pending   stripe.PayoutStatus = "pending"
+	InTransit stripe.PayoutStatus = "in_transit"
+	Canceled  stripe.PayoutStatus = "canceled"
+	Failed    stripe.PayoutStatus = "failed"
+
+	Bank stripe.PayoutType = "bank_account"
+	Card stripe.PayoutType = "card"
+
+	Standard stripe.PayoutMethod = "standard"
+	Instant  stripe.PayoutMethod = "instant"
+)
+
+// Client is used to invoke /payouts APIs.
+type Client struct {
+	B   stripe.Backend
+	Key string
+	// NoValidate disables the client-side validation of params
+	// that is otherwise done before calling the backend.
+	NoValidate bool
+}
+
+// New POSTs a new payout.
+// For more details see https://stripe.com/docs/api#create_payout.
+func New(params *stripe.PayoutParams) (*stripe.Payout, error) {
+	return getC().New(params)
+}
+
+func (c Client) New(params *stripe.PayoutParams) (*stripe.Payout, error) {
+	if err := stripe.ValidateParams(params, c.NoValidate); err != nil {
+		return nil, err
+	}
+
+	body := &url.Values{}
+	form.AppendTo(body, params)
+
+	payout := &stripe.Payout{}
+	err := c.B.Call("POST", "/payouts", c.Key, body, &params.Params, payout)
+
+	return payout, err
+}
+
+// Get returns the details of a payout.
+// For more details see https://stripe.com/docs/api#retrieve_payout.
+func Get(id string, params *stripe.PayoutParams) (*stripe.Payout, error) {
+	return getC().Get(id, params)
+}
+
+func (c Client) Get(id string, params *stripe.PayoutParams) (*stripe.Payout, error) {
+	var body *url.Values
+	var commonParams *stripe.Params
+
+	if params != nil {
+		commonParams = &params.Params
+		body = &url.Values{}
+		params.AppendTo(body)
+	}
+
+	payout := &stripe.Payout{}
+	err := c.B.Call("GET", "/payouts/"+id, c.Key, body, commonParams, payout)
+
+	return payout, err
+}
+
+// Update updates a payout's properties.
+// For more details see https://stripe.com/docs/api#update_payout.
+func Update(id string, params *stripe.PayoutParams) (*stripe.Payout, error) {
+	return getC().Update(id, params)
+}
+
+func (c Client) Update(id string, params *stripe.PayoutParams) (*stripe.Payout, error) {
+	if err := stripe.ValidateParams(params, c.NoValidate); err != nil {
+		return nil, err
+	}
+
+	var body *url.Values
+	var commonParams *stripe.Params
+
+	if params != nil {
+		commonParams = &params.Params
+
+		body = &url.Values{}
+		form.AppendTo(body, params)
+	}
+
+	payout := &stripe.Payout{}
+	err := c.B.Call("POST", "/payouts/"+id, c.Key, body, commonParams, payout)
+
+	return payout, err
+}
+
+// Cancel cancels a pending payout.
+// For more details see https://stripe.com/docs/api#cancel_payout.
+func Cancel(id string, params *stripe.PayoutParams) (*stripe.Payout, error) {
+	return getC().Cancel(id, params)
+}
+
+func (c Client) Cancel(id string, params *stripe.PayoutParams) (*stripe.Payout, error) {
+	var body *url.Values
+	var commonParams *stripe.Params
+
+	if params != nil {
+		commonParams = &params.Params
+
+		body = &url.Values{}
+		params.AppendTo(body)
+	}
+
+	payout := &stripe.Payout{}
+	err := c.B.Call("POST", fmt.Sprintf("/payouts/%v/cancel", id), c.Key, body, commonParams, payout)
+
+	return payout, err
+}
+
+// List returns a list of payouts.
+// For more details see https://stripe.com/docs/api#list_payouts.
+func List(params *stripe.PayoutListParams) *Iter {
+	return getC().List(params)
+}
+
+func (c Client) List(params *stripe.PayoutListParams) *Iter {
+	if err := stripe.ValidateParams(params, c.NoValidate); err != nil {
+		return &Iter{stripe.GetIterErr(err)}
+	}
+
+	var body *url.Values
+	var lp *stripe.ListParams
+
+	if params != nil {
+		body = &url.Values{}
+		form.AppendTo(body, params)
+		lp = &params.ListParams
+	}
+
+	return &Iter{stripe.GetIter(lp, body, func(b url.Values) ([]interface{}, stripe.ListMeta, error) {
+		list := &stripe.PayoutList{}
+		err := c.B.Call("GET", "/payouts", c.Key, &b, nil, list)
+
+		ret := make([]interface{}, len(list.Values))
+		for i, v := range list.Values {
+			ret[i] = v
+		}
+
+		return ret, list.ListMeta, err
+	})}
+}
+
+// Iter is an iterator for lists of Payouts.
+// The embedded Iter carries methods with it;
+// see its documentation for details.
+type Iter struct {
+	*stripe.Iter
+}
+
+// Payout returns the most recent Payout
+// visited by a call to Next.
+func (i *Iter) Payout() *stripe.Payout {
+	return i.Current().(*stripe.Payout)
+}
+
+func getC() Client {
+	return Client{B: stripe.GetBackend(stripe.APIBackend), Key: stripe.Key}
+}
@@ -0,0 +1,93 @@
+package payout
+
+import (
+	"testing"
+
+	stripe "github.com/channelmeter/stripe-go"
+	"github.com/channelmeter/stripe-go/balance"
+	"github.com/channelmeter/stripe-go/currency"
+	. "github.com/channelmeter/stripe-go/utils"
+)
+
+func init() {
+	stripe.Key = GetTestKey()
+}
+
+func TestPayoutNew(t *testing.T) {
+	params := &stripe.PayoutParams{
+		Amount:    100,
+		Currency:  currency.USD,
+		Desc:      "Payout test",
+		Statement: "payout",
+	}
+
+	target, err := New(params)
+
+	if err != nil {
+		t.Error(err)
+	}
+
+	if target.Amount != params.Amount {
+		t.Errorf("Amount %v does not match expected amount %v\n", target.Amount, params.Amount)
+	}
+
+	if target.Currency != params.Currency {
+		t.Errorf("Currency %q does not match expected currency %q\n", target.Currency, params.Currency)
+	}
+
+	if target.Status != Pending && target.Status != InTransit && target.Status != Paid {
+		t.Errorf("Unexpected status %q\n", target.Status)
+	}
+
+	target, err = Update(target.ID, &stripe.PayoutParams{Params: stripe.Params{Meta: map[string]string{"foo": "bar"}}})
+
+	if err != nil {
+		t.Error(err)
+	}
+
+	if target.Meta["foo"] != "bar" {
+		t.Errorf("Metadata %v does not contain the expected value\n", target.Meta)
+	}
+
+	target, err = Cancel(target.ID, nil)
+
+	if err != nil {
+		t.Error(err)
+	}
+
+	if target.Status != Canceled {
+		t.Errorf("Status %q does not match expected status %q\n", target.Status, Canceled)
+	}
+}
+
+func TestPayoutList(t *testing.T) {
+	params := &stripe.PayoutListParams{Status: Paid}
+	params.Filters.AddFilter("limit", "", "5")
+	params.Single = true
+
+	i := List(params)
+	for i.Next() {
+		p := i.Payout()
+
+		if p.Status != Paid {
+			t.Errorf("Status %q does not match expected status %q\n", p.Status, Paid)
+		}
+
+		if !p.Automatic {
+			continue
+		}
+
+		txs := balance.List(&stripe.TxListParams{Payout: p.ID})
+		for txs.Next() {
+			if txs.Transaction() == nil {
+				t.Error("No nil values expected")
+			}
+		}
+		if err := txs.Err(); err != nil {
+			t.Error(err)
+		}
+	}
+	if err := i.Err(); err != nil {
+		t.Error(err)
+	}
+}
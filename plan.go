@@ -4,36 +4,92 @@ package stripe
 // Allowed values are "day", "week", "month", "year".
 type PlanInterval string
 
+// PlanUsageType is the list of allowed values for how the quantity billed by a plan is set.
+// Allowed values are "licensed", "metered".
+type PlanUsageType string
+
+// PlanAggregateUsage is the list of allowed values for how the usage records of a metered plan are aggregated.
+// Allowed values are "sum", "last_during_period", "last_ever", "max".
+type PlanAggregateUsage string
+
 // PlanParams is the set of parameters that can be used when creating or updating a plan.
 // For more details see https://stripe.com/docs/api#create_plan and https://stripe.com/docs/api#update_plan.
 type PlanParams struct {
 	Params
-	ID, Name                   string
-	Currency                   Currency
-	Amount                     uint64
-	Interval                   PlanInterval
-	IntervalCount, TrialPeriod uint64
-	Statement                  string
+	ID             string             `form:"id"`
+	Name           string             `form:"name"`
+	Currency       Currency           `form:"currency"`
+	Amount         uint64             `form:"amount"`
+	Interval       PlanInterval       `form:"interval"`
+	IntervalCount  uint64             `form:"interval_count"`
+	TrialPeriod    uint64             `form:"trial_period_days"`
+	Statement      string             `form:"statement_descriptor"`
+	UsageType      PlanUsageType      `form:"usage_type"`
+	AggregateUsage PlanAggregateUsage `form:"aggregate_usage"`
+}
+
+// Validate checks the plan parameters before they are sent.
+func (p *PlanParams) Validate() error {
+	v := &validation{}
+	p.Params.validate(v)
+	v.statement("statement_descriptor", p.Statement, maxStatementLen)
+	v.interval("interval", p.Interval, "interval_count", p.IntervalCount)
+	v.oneOf("usage_type", string(p.UsageType), "licensed", "metered")
+	v.oneOf("aggregate_usage", string(p.AggregateUsage), "sum", "last_during_period", "last_ever", "max")
+
+	if len(p.AggregateUsage) > 0 && p.UsageType != "metered" {
+		v.add("aggregate_usage", "can only be used with a metered usage type")
+	}
+
+	// only the name, statement descriptor and metadata can be updated,
+	// so any other field means the plan is being created
+	if len(p.ID) > 0 || len(p.Currency) > 0 || p.Amount > 0 || len(p.Interval) > 0 || p.IntervalCount > 0 || p.TrialPeriod > 0 || len(p.UsageType) > 0 {
+		v.required("id", p.ID)
+		v.required("name", p.Name)
+		v.required("currency", string(p.Currency))
+		v.required("interval", string(p.Interval))
+	}
+
+	return v.err()
 }
 
 // PlanListParams is the set of parameters that can be used when listing plans.
 // For more details see https://stripe.com/docs/api#list_plans.
 type PlanListParams struct {
 	ListParams
+	Created      Timestamp    `form:"created"`
+	CreatedRange *RangeParams `form:"created"`
+}
+
+// Validate checks the plan list parameters before they are sent.
+func (p *PlanListParams) Validate() error {
+	v := &validation{}
+	p.ListParams.validate(v)
+	v.timeRange("created", p.Created, p.CreatedRange)
+	return v.err()
 }
 
 // Plan is the resource representing a Stripe plan.
 // For more details see https://stripe.com/docs/api#plans.
 type Plan struct {
-	ID            string            `json:"id"`
-	Live          bool              `json:"livemode"`
-	Amount        uint64            `json:"amount"`
-	Created       int64             `json:"created"`
-	Currency      Currency          `json:"currency"`
-	Interval      PlanInterval      `json:"interval"`
-	IntervalCount uint64            `json:"interval_count"`
-	Name          string            `json:"name"`
-	Meta          map[string]string `json:"metadata"`
-	TrialPeriod   uint64            `json:"trial_period_days"`
-	Statement     string            `json:"statement_descriptor"`
+	Extra
+	ID             string             `json:"id"`
+	Live           bool               `json:"livemode"`
+	Amount         uint64             `json:"amount"`
+	AggregateUsage PlanAggregateUsage `json:"aggregate_usage"`
+	Created        Timestamp          `json:"created"`
+	Currency       Currency           `json:"currency"`
+	Interval       PlanInterval       `json:"interval"`
+	IntervalCount  uint64             `json:"interval_count"`
+	Name           string             `json:"name"`
+	Meta           map[string]string  `json:"metadata"`
+	TrialPeriod    uint64             `json:"trial_period_days"`
+	Statement      string             `json:"statement_descriptor"`
+	UsageType      PlanUsageType      `json:"usage_type"`
+}
+
+// UnmarshalJSON handles deserialization of a Plan.
+func (p *Plan) UnmarshalJSON(data []byte) error {
+	type plan Plan
+	return unmarshalResource(data, (*plan)(p))
 }
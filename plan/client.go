@@ -3,9 +3,9 @@ package plan
 
 import (
 	"net/url"
-	"strconv"
 
 	stripe "github.com/channelmeter/stripe-go"
+	"github.com/channelmeter/stripe-go/form"
 )
 
 const (
@@ -13,12 +13,23 @@ const (
 	Week  stripe.PlanInterval = "week"
 	Month stripe.PlanInterval = "month"
 	Year  stripe.PlanInterval = "year"
+
+	Licensed stripe.PlanUsageType = "licensed"
+	Metered  stripe.PlanUsageType = "metered"
+
+	Sum              stripe.PlanAggregateUsage = "sum"
+	LastDuringPeriod stripe.PlanAggregateUsage = "last_during_period"
+	LastEver         stripe.PlanAggregateUsage = "last_ever"
+	Max              stripe.PlanAggregateUsage = "max"
 )
 
 // Client is used to invoke /plans APIs.
 type Client struct {
 	B   stripe.Backend
 	Key string
+	// NoValidate disables the client-side validation of params
+	// that is otherwise done before calling the backend.
+	NoValidate bool
 }
 
 // New POSTs a new plan.
@@ -28,26 +39,18 @@ func New(params *stripe.PlanParams) (*stripe.Plan, error) {
 }
 
 func (c Client) New(params *stripe.PlanParams) (*stripe.Plan, error) {
-	body := &url.Values{
-		"id":       {params.ID},
-		"name":     {params.Name},
-		"amount":   {strconv.FormatUint(params.Amount, 10)},
-		"currency": {string(params.Currency)},
-		"interval": {string(params.Interval)},
+	if err := stripe.ValidateParams(params, c.NoValidate); err != nil {
+		return nil, err
 	}
 
-	if params.IntervalCount > 0 {
-		body.Add("interval_count", strconv.FormatUint(params.IntervalCount, 10))
-	}
-
-	if params.TrialPeriod > 0 {
-		body.Add("trial_period_days", strconv.FormatUint(params.TrialPeriod, 10))
-	}
+	body := &url.Values{}
+	form.AppendTo(body, params)
 
-	if len(params.Statement) > 0 {
-		body.Add("statement_descriptor", params.Statement)
+	// free plans are created with an explicit zero amount, sent only
+	// once even when the params already mark it with SetZero
+	if params.Amount == 0 {
+		body.Set("amount", "0")
 	}
-	params.AppendTo(body)
 
 	plan := &stripe.Plan{}
 	err := c.B.Call("POST", "/plans", c.Key, body, &params.Params, plan)
@@ -84,22 +87,17 @@ func Update(id string, params *stripe.PlanParams) (*stripe.Plan, error) {
 }
 
 func (c Client) Update(id string, params *stripe.PlanParams) (*stripe.Plan, error) {
+	if err := stripe.ValidateParams(params, c.NoValidate); err != nil {
+		return nil, err
+	}
+
 	var body *url.Values
 	var commonParams *stripe.Params
 
 	if params != nil {
 		commonParams = &params.Params
 		body = &url.Values{}
-
-		if len(params.Name) > 0 {
-			body.Add("name", params.Name)
-		}
-
-		if len(params.Statement) > 0 {
-			body.Add("statement_descriptor", params.Statement)
-		}
-
-		params.AppendTo(body)
+		form.AppendTo(body, params)
 	}
 
 	plan := &stripe.Plan{}
@@ -125,6 +123,10 @@ func List(params *stripe.PlanListParams) *Iter {
 }
 
 func (c Client) List(params *stripe.PlanListParams) *Iter {
+	if err := stripe.ValidateParams(params, c.NoValidate); err != nil {
+		return &Iter{stripe.GetIterErr(err)}
+	}
+
 	type planList struct {
 		stripe.ListMeta
 		Values []*stripe.Plan `json:"data"`
@@ -135,8 +137,7 @@ func (c Client) List(params *stripe.PlanListParams) *Iter {
 
 	if params != nil {
 		body = &url.Values{}
-
-		params.AppendTo(body)
+		form.AppendTo(body, params)
 		lp = &params.ListParams
 	}
 
@@ -167,5 +168,5 @@ func (i *Iter) Plan() *stripe.Plan {
 }
 
 func getC() Client {
-	return Client{stripe.GetBackend(stripe.APIBackend), stripe.Key}
+	return Client{B: stripe.GetBackend(stripe.APIBackend), Key: stripe.Key}
 }
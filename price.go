@@ -0,0 +1,231 @@
+package stripe
+
+import (
+	"encoding/json"
+	"net/url"
+
+	"github.com/channelmeter/stripe-go/form"
+)
+
+// PriceType is the list of allowed values for the price's type.
+// Allowed values are "one_time", "recurring".
+type PriceType string
+
+// PriceBillingScheme is the list of allowed values for how a price is computed.
+// Allowed values are "per_unit", "tiered".
+type PriceBillingScheme string
+
+// PriceTiersMode is the list of allowed values for how the tiers of a price apply.
+// Allowed values are "graduated", "volume".
+type PriceTiersMode string
+
+// PriceParams is the set of parameters that can be used when creating or updating a price.
+// A price is recurring when Recurring is set and one-time otherwise.
+// Only the active flag, nickname, lookup key and metadata can be updated.
+// For more details see https://stripe.com/docs/api#create_price and https://stripe.com/docs/api#update_price.
+type PriceParams struct {
+	Params
+	Active            bool                    `form:"active"`
+	BillingScheme     PriceBillingScheme      `form:"billing_scheme"`
+	Currency          Currency                `form:"currency"`
+	LookupKey         string                  `form:"lookup_key"`
+	Nickname          string                  `form:"nickname"`
+	Product           string                  `form:"product"`
+	ProductData       *PriceProductDataParams `form:"product_data"`
+	Recurring         *PriceRecurringParams   `form:"recurring"`
+	Tiers             []*PriceTierParams      `form:"tiers"`
+	TiersMode         PriceTiersMode          `form:"tiers_mode"`
+	TransferLookupKey bool                    `form:"transfer_lookup_key"`
+	UnitAmount        uint64                  `form:"unit_amount"`
+}
+
+// PriceProductDataParams is the product created inline along with a price.
+type PriceProductDataParams struct {
+	Name      string `form:"name"`
+	Statement string `form:"statement_descriptor"`
+	UnitLabel string `form:"unit_label"`
+}
+
+// PriceRecurringParams is the billing interval of a recurring price.
+type PriceRecurringParams struct {
+	Interval      PlanInterval `form:"interval"`
+	IntervalCount uint64       `form:"interval_count"`
+}
+
+// PriceTierParams is a tier of a tiered price. The last tier has no upper
+// bound, which is set with UpToInf.
+type PriceTierParams struct {
+	UpTo       uint64 `form:"up_to"`
+	UpToInf    bool   `form:"-"`
+	FlatAmount uint64 `form:"flat_amount"`
+	UnitAmount uint64 `form:"unit_amount"`
+}
+
+// AppendForm implements form.Appender for tiers, which send "inf" as the
+// upper bound of the last tier.
+func (t *PriceTierParams) AppendForm(values *url.Values, keyParts []string) {
+	form.AppendFields(values, t, keyParts)
+
+	if t.UpToInf {
+		values.Set(form.FormatKey(append(keyParts[:len(keyParts):len(keyParts)], "up_to")), "inf")
+	}
+}
+
+// Validate checks the price parameters before they are sent.
+func (p *PriceParams) Validate() error {
+	v := &validation{}
+	p.Params.validate(v)
+	v.oneOf("billing_scheme", string(p.BillingScheme), "per_unit", "tiered")
+	v.oneOf("tiers_mode", string(p.TiersMode), "graduated", "volume")
+
+	// the currency is only sent when creating a price
+	if len(p.Currency) > 0 {
+		if len(p.Product) == 0 && p.ProductData == nil {
+			v.add("product", "either product or product_data must be set")
+		}
+	}
+
+	if len(p.Product) > 0 && p.ProductData != nil {
+		v.add("product_data", "cannot be used together with product")
+	}
+
+	if p.ProductData != nil {
+		v.required("product_data[name]", p.ProductData.Name)
+		v.statement("product_data[statement_descriptor]", p.ProductData.Statement, maxStatementLen)
+	}
+
+	if p.Recurring != nil {
+		v.required("recurring[interval]", string(p.Recurring.Interval))
+		v.interval("recurring[interval]", p.Recurring.Interval, "recurring[interval_count]", p.Recurring.IntervalCount)
+	}
+
+	if p.BillingScheme == "tiered" {
+		if p.UnitAmount > 0 {
+			v.add("unit_amount", "cannot be used with a tiered billing scheme")
+		}
+
+		v.required("tiers_mode", string(p.TiersMode))
+
+		if len(p.Tiers) == 0 {
+			v.add("tiers", "is required with a tiered billing scheme")
+		}
+	} else if len(p.Tiers) > 0 || len(p.TiersMode) > 0 {
+		v.add("tiers", "can only be used with a tiered billing scheme")
+	}
+
+	for i, t := range p.Tiers {
+		last := i == len(p.Tiers)-1
+		switch {
+		case t.UpToInf && !last:
+			v.add("tiers", "only the last tier can be unbounded")
+		case !t.UpToInf && last:
+			v.add("tiers", "the last tier must be unbounded")
+		case i > 0 && !p.Tiers[i-1].UpToInf && !t.UpToInf && t.UpTo <= p.Tiers[i-1].UpTo:
+			v.add("tiers", "the bounds must be increasing")
+		}
+	}
+
+	return v.err()
+}
+
+// PriceListParams is the set of parameters that can be used when listing prices.
+// For more details see https://stripe.com/docs/api#list_prices.
+type PriceListParams struct {
+	ListParams
+	Active       bool         `form:"active"`
+	Inactive     bool         `form:"active,invert"`
+	Created      Timestamp    `form:"created"`
+	CreatedRange *RangeParams `form:"created"`
+	Currency     Currency     `form:"currency"`
+	LookupKeys   []string     `form:"lookup_keys"`
+	Product      string       `form:"product"`
+	Type         PriceType    `form:"type"`
+}
+
+// Validate checks the price list parameters before they are sent.
+func (p *PriceListParams) Validate() error {
+	v := &validation{}
+	p.ListParams.validate(v)
+	v.timeRange("created", p.Created, p.CreatedRange)
+	v.oneOf("type", string(p.Type), "one_time", "recurring")
+
+	if p.Active && p.Inactive {
+		v.add("active", "cannot list both only active and only inactive prices")
+	}
+
+	return v.err()
+}
+
+// Price is the resource representing a Stripe price.
+// For more details see https://stripe.com/docs/api#prices.
+type Price struct {
+	Extra
+	ID            string             `json:"id"`
+	Live          bool               `json:"livemode"`
+	Active        bool               `json:"active"`
+	BillingScheme PriceBillingScheme `json:"billing_scheme"`
+	Created       Timestamp          `json:"created"`
+	Currency      Currency           `json:"currency"`
+	LookupKey     string             `json:"lookup_key"`
+	Meta          map[string]string  `json:"metadata"`
+	Nickname      string             `json:"nickname"`
+	Product       *Product           `json:"product"`
+	Recurring     *PriceRecurring    `json:"recurring"`
+	Tiers         []*PriceTier       `json:"tiers"`
+	TiersMode     PriceTiersMode     `json:"tiers_mode"`
+	Type          PriceType          `json:"type"`
+	UnitAmount    uint64             `json:"unit_amount"`
+	Expanded      bool               `json:"-"`
+}
+
+// PriceRecurring is the billing interval of a recurring price.
+type PriceRecurring struct {
+	Interval      PlanInterval `json:"interval"`
+	IntervalCount uint64       `json:"interval_count"`
+}
+
+// PriceTier is a tier of a tiered price. The last tier is unbounded, which
+// is reported with UpToInf.
+type PriceTier struct {
+	FlatAmount uint64 `json:"flat_amount"`
+	UnitAmount uint64 `json:"unit_amount"`
+	UpTo       uint64 `json:"up_to"`
+	UpToInf    bool   `json:"-"`
+}
+
+// PriceList is a list object for prices.
+type PriceList struct {
+	ListMeta
+	Values []*Price `json:"data"`
+}
+
+// UnmarshalJSON handles deserialization of a Price.
+// This custom unmarshaling is needed because the resulting
+// property may be an id or the full struct if it was expanded.
+func (p *Price) UnmarshalJSON(data []byte) error {
+	type price Price
+	return unmarshalExpandable(data, &p.ID, &p.Expanded, (*price)(p))
+}
+
+// UnmarshalJSON handles deserialization of a PriceTier, whose upper bound
+// is null for the last tier.
+func (t *PriceTier) UnmarshalJSON(data []byte) error {
+	type tier PriceTier
+	var raw struct {
+		tier
+		UpTo *uint64 `json:"up_to"`
+	}
+
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	*t = PriceTier(raw.tier)
+	if raw.UpTo != nil {
+		t.UpTo = *raw.UpTo
+	} else {
+		t.UpToInf = true
+	}
+
+	return nil
+}
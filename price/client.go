@@ -0,0 +1,153 @@
+// Package price provides the /prices APIs
+package price
+
+import (
+	"net/url"
+
+	stripe "github.com/channelmeter/stripe-go"
+	"github.com/channelmeter/stripe-go/form"
+)
+
+const (
+	OneTime   stripe.PriceType = "one_time"
+	Recurring stripe.PriceType = "recurring"
+
+	PerUnit stripe.PriceBillingScheme = "per_unit"
+	Tiered  stripe.PriceBillingScheme = "tiered"
+
+	Graduated stripe.PriceTiersMode = "graduated"
+	Volume    stripe.PriceTiersMode = "volume"
+)
+
+// Client is used to invoke /prices APIs.
+type Client struct {
+	B   stripe.Backend
+	Key string
+	// NoValidate disables the client-side validation of params
+	// that is otherwise done before calling the backend.
+	NoValidate bool
+}
+
+// New POSTs a new price.
+// For more details see https://stripe.com/docs/api#create_price.
+func New(params *stripe.PriceParams) (*stripe.Price, error) {
+	return getC().New(params)
+}
+
+func (c Client) New(params *stripe.PriceParams) (*stripe.Price, error) {
+	if err := stripe.ValidateParams(params, c.NoValidate); err != nil {
+		return nil, err
+	}
+
+	body := &url.Values{}
+	form.AppendTo(body, params)
+
+	// free per-unit prices are created with an explicit zero amount, sent
+	// only once even when the params already mark it with SetZero
+	if params.BillingScheme != Tiered && params.UnitAmount == 0 {
+		body.Set("unit_amount", "0")
+	}
+
+	price := &stripe.Price{}
+	err := c.B.Call("POST", "/prices", c.Key, body, stripe.CurrentVersion(&params.Params), price)
+
+	return price, err
+}
+
+// Get returns the details of a price.
+// For more details see https://stripe.com/docs/api#retrieve_price.
+func Get(id string, params *stripe.PriceParams) (*stripe.Price, error) {
+	return getC().Get(id, params)
+}
+
+func (c Client) Get(id string, params *stripe.PriceParams) (*stripe.Price, error) {
+	var body *url.Values
+	var commonParams *stripe.Params
+
+	if params != nil {
+		commonParams = &params.Params
+		body = &url.Values{}
+		params.AppendTo(body)
+	}
+
+	price := &stripe.Price{}
+	err := c.B.Call("GET", "/prices/"+id, c.Key, body, stripe.CurrentVersion(commonParams), price)
+
+	return price, err
+}
+
+// Update updates a price's properties.
+// For more details see https://stripe.com/docs/api#update_price.
+func Update(id string, params *stripe.PriceParams) (*stripe.Price, error) {
+	return getC().Update(id, params)
+}
+
+func (c Client) Update(id string, params *stripe.PriceParams) (*stripe.Price, error) {
+	if err := stripe.ValidateParams(params, c.NoValidate); err != nil {
+		return nil, err
+	}
+
+	var body *url.Values
+	var commonParams *stripe.Params
+
+	if params != nil {
+		commonParams = &params.Params
+		body = &url.Values{}
+		form.AppendTo(body, params)
+	}
+
+	price := &stripe.Price{}
+	err := c.B.Call("POST", "/prices/"+id, c.Key, body, stripe.CurrentVersion(commonParams), price)
+
+	return price, err
+}
+
+// List returns a list of prices.
+// For more details see https://stripe.com/docs/api#list_prices.
+func List(params *stripe.PriceListParams) *Iter {
+	return getC().List(params)
+}
+
+func (c Client) List(params *stripe.PriceListParams) *Iter {
+	if err := stripe.ValidateParams(params, c.NoValidate); err != nil {
+		return &Iter{stripe.GetIterErr(err)}
+	}
+
+	var body *url.Values
+	var lp *stripe.ListParams
+
+	if params != nil {
+		body = &url.Values{}
+		form.AppendTo(body, params)
+		lp = &params.ListParams
+	}
+
+	return &Iter{stripe.GetIter(lp, body, func(b url.Values) ([]interface{}, stripe.ListMeta, error) {
+		list := &stripe.PriceList{}
+		err := c.B.Call("GET", "/prices", c.Key, &b, stripe.CurrentVersion(nil), list)
+
+		ret := make([]interface{}, len(list.Values))
+		for i, v := range list.Values {
+			ret[i] = v
+		}
+
+		return ret, list.ListMeta, err
+	})}
+}
+
+// Iter is an iterator for lists of Prices.
+// The embedded Iter carries methods with it;
+// see its documentation for details.
+type Iter struct {
+	*stripe.Iter
+}
+
+// Price returns the most recent Price
+// visited by a call to Next.
+func (i *Iter) Price() *stripe.Price {
+	return i.Current().(*stripe.Price)
+}
+
+func getC() Client {
+	return Client{B: stripe.GetBackend(stripe.APIBackend), Key: stripe.Key}
+}
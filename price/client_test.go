@@ -0,0 +1,117 @@
+package price
+
+import (
+	"testing"
+
+	stripe "github.com/channelmeter/stripe-go"
+	"github.com/channelmeter/stripe-go/currency"
+	"github.com/channelmeter/stripe-go/plan"
+	. "github.com/channelmeter/stripe-go/utils"
+)
+
+func init() {
+	stripe.Key = GetTestKey()
+}
+
+func TestPriceNewRecurring(t *testing.T) {
+	params := &stripe.PriceParams{
+		Currency:    currency.USD,
+		UnitAmount:  1500,
+		LookupKey:   "test_recurring",
+		ProductData: &stripe.PriceProductDataParams{Name: "Test Product"},
+		Recurring:   &stripe.PriceRecurringParams{Interval: plan.Month},
+	}
+	params.TransferLookupKey = true
+
+	target, err := New(params)
+
+	if err != nil {
+		t.Error(err)
+	}
+
+	if target.Type != Recurring {
+		t.Errorf("Type %q does not match expected type %q\n", target.Type, Recurring)
+	}
+
+	if target.UnitAmount != params.UnitAmount {
+		t.Errorf("Unit amount %v does not match expected amount %v\n", target.UnitAmount, params.UnitAmount)
+	}
+
+	if target.Recurring == nil || target.Recurring.Interval != plan.Month {
+		t.Errorf("Recurring %+v does not match expected interval %q\n", target.Recurring, plan.Month)
+	}
+
+	if target.LookupKey != params.LookupKey {
+		t.Errorf("Lookup key %q does not match expected key %q\n", target.LookupKey, params.LookupKey)
+	}
+
+	i := List(&stripe.PriceListParams{LookupKeys: []string{params.LookupKey}})
+	for i.Next() {
+		if i.Price().ID != target.ID {
+			t.Errorf("Price %q does not match expected price %q\n", i.Price().ID, target.ID)
+		}
+	}
+	if err := i.Err(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestPriceNewTiered(t *testing.T) {
+	params := &stripe.PriceParams{
+		Currency:      currency.USD,
+		ProductData:   &stripe.PriceProductDataParams{Name: "Test Product"},
+		Recurring:     &stripe.PriceRecurringParams{Interval: plan.Month},
+		BillingScheme: Tiered,
+		TiersMode:     Graduated,
+		Tiers: []*stripe.PriceTierParams{
+			{UpTo: 10, UnitAmount: 500},
+			{UpToInf: true, UnitAmount: 400},
+		},
+	}
+	params.Expand("tiers")
+
+	target, err := New(params)
+
+	if err != nil {
+		t.Error(err)
+	}
+
+	if target.BillingScheme != Tiered {
+		t.Errorf("Billing scheme %q does not match expected scheme %q\n", target.BillingScheme, Tiered)
+	}
+
+	if len(target.Tiers) != 2 || target.Tiers[0].UpTo != 10 || !target.Tiers[1].UpToInf {
+		t.Errorf("Tiers %v do not match the expected tiers\n", target.Tiers)
+	}
+}
+
+func TestPriceNewOneTime(t *testing.T) {
+	params := &stripe.PriceParams{
+		Currency:    currency.EUR,
+		UnitAmount:  2000,
+		ProductData: &stripe.PriceProductDataParams{Name: "Test Product"},
+	}
+
+	target, err := New(params)
+
+	if err != nil {
+		t.Error(err)
+	}
+
+	if target.Type != OneTime {
+		t.Errorf("Type %q does not match expected type %q\n", target.Type, OneTime)
+	}
+
+	params = &stripe.PriceParams{Nickname: "Updated"}
+	params.SetZero("active")
+
+	target, err = Update(target.ID, params)
+
+	if err != nil {
+		t.Error(err)
+	}
+
+	if target.Active || target.Nickname != "Updated" {
+		t.Errorf("Price %+v was not updated\n", target)
+	}
+}
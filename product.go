@@ -0,0 +1,98 @@
+package stripe
+
+// ProductType is the list of allowed values for the product's type.
+// Allowed values are "service", "good".
+type ProductType string
+
+// ProductParams is the set of parameters that can be used when creating or updating a product.
+// Active is only sent when true, so deactivating a product is done with SetZero("active").
+// For more details see https://stripe.com/docs/api#create_product and https://stripe.com/docs/api#update_product.
+type ProductParams struct {
+	Params
+	ID        string      `form:"id"`
+	Name      string      `form:"name"`
+	Type      ProductType `form:"type"`
+	Active    bool        `form:"active"`
+	Desc      string      `form:"description"`
+	Images    []string    `form:"images"`
+	Shippable bool        `form:"shippable"`
+	Statement string      `form:"statement_descriptor"`
+	UnitLabel string      `form:"unit_label"`
+	URL       string      `form:"url"`
+}
+
+// Validate checks the product parameters before they are sent.
+func (p *ProductParams) Validate() error {
+	v := &validation{}
+	p.Params.validate(v)
+	v.statement("statement_descriptor", p.Statement, maxStatementLen)
+	v.oneOf("type", string(p.Type), "service", "good")
+
+	if len(p.Images) > 8 {
+		v.add("images", "must have at most 8 images")
+	}
+
+	return v.err()
+}
+
+// ProductListParams is the set of parameters that can be used when listing products.
+// For more details see https://stripe.com/docs/api#list_products.
+type ProductListParams struct {
+	ListParams
+	Active       bool         `form:"active"`
+	Inactive     bool         `form:"active,invert"`
+	Created      Timestamp    `form:"created"`
+	CreatedRange *RangeParams `form:"created"`
+	IDs          []string     `form:"ids"`
+	Shippable    bool         `form:"shippable"`
+	Type         ProductType  `form:"type"`
+	URL          string       `form:"url"`
+}
+
+// Validate checks the product list parameters before they are sent.
+func (p *ProductListParams) Validate() error {
+	v := &validation{}
+	p.ListParams.validate(v)
+	v.timeRange("created", p.Created, p.CreatedRange)
+
+	if p.Active && p.Inactive {
+		v.add("active", "cannot list both only active and only inactive products")
+	}
+
+	return v.err()
+}
+
+// Product is the resource representing a Stripe product.
+// For more details see https://stripe.com/docs/api#products.
+type Product struct {
+	Extra
+	ID        string            `json:"id"`
+	Live      bool              `json:"livemode"`
+	Active    bool              `json:"active"`
+	Created   Timestamp         `json:"created"`
+	Desc      string            `json:"description"`
+	Images    []string          `json:"images"`
+	Meta      map[string]string `json:"metadata"`
+	Name      string            `json:"name"`
+	Shippable bool              `json:"shippable"`
+	Statement string            `json:"statement_descriptor"`
+	Type      ProductType       `json:"type"`
+	UnitLabel string            `json:"unit_label"`
+	Updated   Timestamp         `json:"updated"`
+	URL       string            `json:"url"`
+	Expanded  bool              `json:"-"`
+}
+
+// ProductList is a list object for products.
+type ProductList struct {
+	ListMeta
+	Values []*Product `json:"data"`
+}
+
+// UnmarshalJSON handles deserialization of a Product.
+// This custom unmarshaling is needed because the resulting
+// property may be an id or the full struct if it was expanded.
+func (p *Product) UnmarshalJSON(data []byte) error {
+	type product Product
+	return unmarshalExpandable(data, &p.ID, &p.Expanded, (*product)(p))
+}
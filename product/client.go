@@ -0,0 +1,151 @@
+// Package product provides the /products APIs
+package product
+
+import (
+	"net/url"
+
+	stripe "github.com/channelmeter/stripe-go"
+	"github.com/channelmeter/stripe-go/form"
+)
+
+const (
+	Service stripe.ProductType = "service"
+	Good    stripe.ProductType = "good"
+)
+
+// Client is used to invoke /products APIs.
+type Client struct {
+	B   stripe.Backend
+	Key string
+	// NoValidate disables the client-side validation of params
+	// that is otherwise done before calling the backend.
+	NoValidate bool
+}
+
+// New POSTs a new product.
+// For more details see https://stripe.com/docs/api#create_product.
+func New(params *stripe.ProductParams) (*stripe.Product, error) {
+	return getC().New(params)
+}
+
+func (c Client) New(params *stripe.ProductParams) (*stripe.Product, error) {
+	if err := stripe.ValidateParams(params, c.NoValidate); err != nil {
+		return nil, err
+	}
+
+	body := &url.Values{}
+	form.AppendTo(body, params)
+
+	product := &stripe.Product{}
+	err := c.B.Call("POST", "/products", c.Key, body, stripe.CurrentVersion(&params.Params), product)
+
+	return product, err
+}
+
+// Get returns the details of a product.
+// For more details see https://stripe.com/docs/api#retrieve_product.
+func Get(id string, params *stripe.ProductParams) (*stripe.Product, error) {
+	return getC().Get(id, params)
+}
+
+func (c Client) Get(id string, params *stripe.ProductParams) (*stripe.Product, error) {
+	var body *url.Values
+	var commonParams *stripe.Params
+
+	if params != nil {
+		commonParams = &params.Params
+		body = &url.Values{}
+		params.AppendTo(body)
+	}
+
+	product := &stripe.Product{}
+	err := c.B.Call("GET", "/products/"+id, c.Key, body, stripe.CurrentVersion(commonParams), product)
+
+	return product, err
+}
+
+// Update updates a product's properties.
+// For more details see https://stripe.com/docs/api#update_product.
+func Update(id string, params *stripe.ProductParams) (*stripe.Product, error) {
+	return getC().Update(id, params)
+}
+
+func (c Client) Update(id string, params *stripe.ProductParams) (*stripe.Product, error) {
+	if err := stripe.ValidateParams(params, c.NoValidate); err != nil {
+		return nil, err
+	}
+
+	var body *url.Values
+	var commonParams *stripe.Params
+
+	if params != nil {
+		commonParams = &params.Params
+		body = &url.Values{}
+		form.AppendTo(body, params)
+	}
+
+	product := &stripe.Product{}
+	err := c.B.Call("POST", "/products/"+id, c.Key, body, stripe.CurrentVersion(commonParams), product)
+
+	return product, err
+}
+
+// Del removes a product.
+// For more details see https://stripe.com/docs/api#delete_product.
+func Del(id string) error {
+	return getC().Del(id)
+}
+
+func (c Client) Del(id string) error {
+	return c.B.Call("DELETE", "/products/"+id, c.Key, nil, stripe.CurrentVersion(nil), nil)
+}
+
+// List returns a list of products.
+// For more details see https://stripe.com/docs/api#list_products.
+func List(params *stripe.ProductListParams) *Iter {
+	return getC().List(params)
+}
+
+func (c Client) List(params *stripe.ProductListParams) *Iter {
+	if err := stripe.ValidateParams(params, c.NoValidate); err != nil {
+		return &Iter{stripe.GetIterErr(err)}
+	}
+
+	var body *url.Values
+	var lp *stripe.ListParams
+
+	if params != nil {
+		body = &url.Values{}
+		form.AppendTo(body, params)
+		lp = &params.ListParams
+	}
+
+	return &Iter{stripe.GetIter(lp, body, func(b url.Values) ([]interface{}, stripe.ListMeta, error) {
+		list := &stripe.ProductList{}
+		err := c.B.Call("GET", "/products", c.Key, &b, stripe.CurrentVersion(nil), list)
+
+		ret := make([]interface{}, len(list.Values))
+		for i, v := range list.Values {
+			ret[i] = v
+		}
+
+		return ret, list.ListMeta, err
+	})}
+}
+
+// Iter is an iterator for lists of Products.
+// The embedded Iter carries methods with it;
+// see its documentation for details.
+type Iter struct {
+	*stripe.Iter
+}
+
+// Product returns the most recent Product
+// visited by a call to Next.
+func (i *Iter) Product() *stripe.Product {
+	return i.Current().(*stripe.Product)
+}
+
+func getC() Client {
+	return Client{B: stripe.GetBackend(stripe.APIBackend), Key: stripe.Key}
+}
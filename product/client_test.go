@@ -0,0 +1,80 @@
+package product
+
+import (
+	"testing"
+
+	stripe "github.com/channelmeter/stripe-go"
+	. "github.com/channelmeter/stripe-go/utils"
+)
+
+func init() {
+	stripe.Key = GetTestKey()
+}
+
+func TestProductNew(t *testing.T) {
+	params := &stripe.ProductParams{
+		Name:      "Test Product",
+		Type:      Service,
+		Desc:      "A product",
+		UnitLabel: "seat",
+	}
+
+	target, err := New(params)
+
+	if err != nil {
+		t.Error(err)
+	}
+
+	if target.Name != params.Name {
+		t.Errorf("Name %q does not match expected name %q\n", target.Name, params.Name)
+	}
+
+	if target.Type != params.Type {
+		t.Errorf("Type %q does not match expected type %q\n", target.Type, params.Type)
+	}
+
+	if !target.Active {
+		t.Errorf("Product is not active\n")
+	}
+
+	Del(target.ID)
+}
+
+func TestProductUpdate(t *testing.T) {
+	target, _ := New(&stripe.ProductParams{Name: "Test Product", Type: Service})
+
+	params := &stripe.ProductParams{Name: "Updated Product"}
+	params.SetZero("active")
+
+	updated, err := Update(target.ID, params)
+
+	if err != nil {
+		t.Error(err)
+	}
+
+	if updated.Name != params.Name {
+		t.Errorf("Name %q does not match expected name %q\n", updated.Name, params.Name)
+	}
+
+	if updated.Active {
+		t.Errorf("Product is still active\n")
+	}
+
+	Del(target.ID)
+}
+
+func TestProductList(t *testing.T) {
+	params := &stripe.ProductListParams{Active: true}
+	params.Filters.AddFilter("limit", "", "5")
+	params.Single = true
+
+	i := List(params)
+	for i.Next() {
+		if !i.Product().Active {
+			t.Error("Only active products expected")
+		}
+	}
+	if err := i.Err(); err != nil {
+		t.Error(err)
+	}
+}
@@ -1,6 +1,10 @@
 package stripe
 
-import "encoding/json"
+import (
+	"net/url"
+
+	"github.com/channelmeter/stripe-go/form"
+)
 
 // RecipientType is the list of allowed values for the recipient's type.
 // Allowed values are "individual", "corporation".
@@ -10,27 +14,64 @@ type RecipientType string
 // For more details see https://stripe.com/docs/api#create_recipient and https://stripe.com/docs/api#update_recipient.
 type RecipientParams struct {
 	Params
-	Name                      string
-	Type                      RecipientType
-	TaxID, Token, Email, Desc string
-	Bank                      *BankAccountParams
-	Card                      *CardParams
-	DefaultCard               string
+	Name        string             `form:"name"`
+	Type        RecipientType      `form:"type"`
+	TaxID       string             `form:"tax_id"`
+	Token       string             `form:"-"`
+	Email       string             `form:"email"`
+	Desc        string             `form:"description"`
+	Bank        *BankAccountParams `form:"bank_account"`
+	Card        *CardParams        `form:"-"`
+	DefaultCard string             `form:"default_card"`
+}
+
+// Validate checks the recipient parameters before they are sent.
+func (p *RecipientParams) Validate() error {
+	v := &validation{}
+	p.Params.validate(v)
+	v.oneOf("type", string(p.Type), "individual", "corporation")
+
+	if len(p.Token) > 0 && (p.Bank != nil || p.Card != nil) {
+		v.add("bank_account", "cannot be used together with a token")
+	}
+
+	if p.Bank != nil {
+		v.nested(p.Bank.Validate())
+	}
+
+	if p.Card != nil {
+		v.nested(p.Card.Validate())
+	}
+
+	return v.err()
+}
+
+// AppendForm implements form.Appender for recipients, which send a token in
+// place of the card.
+func (p *RecipientParams) AppendForm(values *url.Values, keyParts []string) {
+	form.AppendFields(values, p, keyParts)
+
+	if len(p.Token) > 0 {
+		values.Add("card", p.Token)
+	} else if p.Card != nil {
+		p.Card.AppendForm(values, []string{"card"})
+	}
 }
 
 // RecipientListParams is the set of parameters that can be used when listing recipients.
 // For more details see https://stripe.com/docs/api#list_recipients.
 type RecipientListParams struct {
 	ListParams
-	Verified bool
+	Verified bool `form:"verified"`
 }
 
 // Recipient is the resource representing a Stripe recipient.
 // For more details see https://stripe.com/docs/api#recipients.
 type Recipient struct {
+	Extra
 	ID          string            `json:"id"`
 	Live        bool              `json:"livemode"`
-	Created     int64             `json:"created"`
+	Created     Timestamp         `json:"created"`
 	Type        RecipientType     `json:"type"`
 	Bank        *BankAccount      `json:"active_account"`
 	Desc        string            `json:"description"`
@@ -39,6 +80,7 @@ type Recipient struct {
 	Name        string            `json:"name"`
 	Cards       *CardList         `json:"cards"`
 	DefaultCard *Card             `json:"default_card"`
+	Expanded    bool              `json:"-"`
 }
 
 // UnmarshalJSON handles deserialization of a Recipient.
@@ -46,14 +88,5 @@ type Recipient struct {
 // property may be an id or the full struct if it was expanded.
 func (r *Recipient) UnmarshalJSON(data []byte) error {
 	type recipient Recipient
-	var rr recipient
-	err := json.Unmarshal(data, &rr)
-	if err == nil {
-		*r = Recipient(rr)
-	} else {
-		// the id is surrounded by "\" characters, so strip them
-		r.ID = string(data[1 : len(data)-1])
-	}
-
-	return nil
+	return unmarshalExpandable(data, &r.ID, &r.Expanded, (*recipient)(r))
 }
@@ -3,9 +3,9 @@ package recipient
 
 import (
 	"net/url"
-	"strconv"
 
 	stripe "github.com/channelmeter/stripe-go"
+	"github.com/channelmeter/stripe-go/form"
 )
 
 const (
@@ -17,6 +17,9 @@ const (
 type Client struct {
 	B   stripe.Backend
 	Key string
+	// NoValidate disables the client-side validation of params
+	// that is otherwise done before calling the backend.
+	NoValidate bool
 }
 
 // New POSTs a new recipient.
@@ -26,37 +29,12 @@ func New(params *stripe.RecipientParams) (*stripe.Recipient, error) {
 }
 
 func (c Client) New(params *stripe.RecipientParams) (*stripe.Recipient, error) {
-	body := &url.Values{
-		"name": {params.Name},
-		"type": {string(params.Type)},
+	if err := stripe.ValidateParams(params, c.NoValidate); err != nil {
+		return nil, err
 	}
 
-	if params.Bank != nil {
-		if len(params.Bank.Token) > 0 {
-			body.Add("bank_account", params.Bank.Token)
-		} else {
-			params.Bank.AppendDetails(body)
-		}
-	}
-
-	if len(params.Token) > 0 {
-		body.Add("card", params.Token)
-	} else if params.Card != nil {
-		params.Card.AppendDetails(body, true)
-	}
-
-	if len(params.TaxID) > 0 {
-		body.Add("tax_id", params.TaxID)
-	}
-
-	if len(params.Email) > 0 {
-		body.Add("email", params.Email)
-	}
-
-	if len(params.Desc) > 0 {
-		body.Add("description", params.Desc)
-	}
-	params.AppendTo(body)
+	body := &url.Values{}
+	form.AppendTo(body, params)
 
 	recipient := &stripe.Recipient{}
 	err := c.B.Call("POST", "/recipients", c.Key, body, &params.Params, recipient)
@@ -93,44 +71,17 @@ func Update(id string, params *stripe.RecipientParams) (*stripe.Recipient, error
 }
 
 func (c Client) Update(id string, params *stripe.RecipientParams) (*stripe.Recipient, error) {
+	if err := stripe.ValidateParams(params, c.NoValidate); err != nil {
+		return nil, err
+	}
+
 	var body *url.Values
 	var commonParams *stripe.Params
 
 	if params != nil {
 		commonParams = &params.Params
 		body = &url.Values{}
-
-		if len(params.Name) > 0 {
-			body.Add("name", params.Name)
-		}
-
-		if params.Bank != nil {
-			params.Bank.AppendDetails(body)
-		}
-
-		if len(params.Token) > 0 {
-			body.Add("card", params.Token)
-		} else if params.Card != nil {
-			params.Card.AppendDetails(body, true)
-		}
-
-		if len(params.TaxID) > 0 {
-			body.Add("tax_id", params.TaxID)
-		}
-
-		if len(params.DefaultCard) > 0 {
-			body.Add("default_card", params.DefaultCard)
-		}
-
-		if len(params.Email) > 0 {
-			body.Add("email", params.Email)
-		}
-
-		if len(params.Desc) > 0 {
-			body.Add("description", params.Desc)
-		}
-
-		params.AppendTo(body)
+		form.AppendTo(body, params)
 	}
 
 	recipient := &stripe.Recipient{}
@@ -156,6 +107,10 @@ func List(params *stripe.RecipientListParams) *Iter {
 }
 
 func (c Client) List(params *stripe.RecipientListParams) *Iter {
+	if err := stripe.ValidateParams(params, c.NoValidate); err != nil {
+		return &Iter{stripe.GetIterErr(err)}
+	}
+
 	type recipientList struct {
 		stripe.ListMeta
 		Values []*stripe.Recipient `json:"data"`
@@ -166,12 +121,7 @@ func (c Client) List(params *stripe.RecipientListParams) *Iter {
 
 	if params != nil {
 		body = &url.Values{}
-
-		if params.Verified {
-			body.Add("verified", strconv.FormatBool(true))
-		}
-
-		params.AppendTo(body)
+		form.AppendTo(body, params)
 		lp = &params.ListParams
 	}
 
@@ -202,5 +152,5 @@ func (i *Iter) Recipient() *stripe.Recipient {
 }
 
 func getC() Client {
-	return Client{stripe.GetBackend(stripe.APIBackend), stripe.Key}
+	return Client{B: stripe.GetBackend(stripe.APIBackend), Key: stripe.Key}
 }
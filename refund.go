@@ -1,7 +1,5 @@
 package stripe
 
-import "encoding/json"
-
 // RefundReason, if set, is the reason the refund is being made--allowed values
 // are "fraudulent", "duplicate", and "requested_by_customer".
 type RefundReason string
@@ -10,10 +8,19 @@ type RefundReason string
 // For more details see https://stripe.com/docs/api#refund.
 type RefundParams struct {
 	Params
-	Charge        string
-	Amount        uint64
-	Fee, Transfer bool
-	Reason        RefundReason
+	Charge   string       `form:"-"`
+	Amount   uint64       `form:"amount"`
+	Fee      bool         `form:"refund_application_fee"`
+	Transfer bool         `form:"refund_transfer"`
+	Reason   RefundReason `form:"reason"`
+}
+
+// Validate checks the refund parameters before they are sent.
+func (p *RefundParams) Validate() error {
+	v := &validation{}
+	p.Params.validate(v)
+	v.oneOf("reason", string(p.Reason), "duplicate", "fraudulent", "requested_by_customer")
+	return v.err()
 }
 
 // RefundListParams is the set of parameters that can be used when listing refunds.
@@ -26,14 +33,16 @@ type RefundListParams struct {
 // Refund is the resource representing a Stripe refund.
 // For more details see https://stripe.com/docs/api#refunds.
 type Refund struct {
+	Extra
 	ID       string            `json:"id"`
 	Amount   uint64            `json:"amount"`
-	Created  int64             `json:"created"`
+	Created  Timestamp         `json:"created"`
 	Currency Currency          `json:"currency"`
 	Tx       *Transaction      `json:"balance_transaction"`
 	Charge   string            `json:"charge"`
 	Meta     map[string]string `json:"metadata"`
 	Reason   RefundReason      `json:"reason"`
+	Expanded bool              `json:"-"`
 }
 
 // RefundList is a list object for refunds.
@@ -47,14 +56,5 @@ type RefundList struct {
 // property may be an id or the full struct if it was expanded.
 func (r *Refund) UnmarshalJSON(data []byte) error {
 	type refund Refund
-	var rr refund
-	err := json.Unmarshal(data, &rr)
-	if err == nil {
-		*r = Refund(rr)
-	} else {
-		// the id is surrounded by "\" characters, so strip them
-		r.ID = string(data[1 : len(data)-1])
-	}
-
-	return nil
+	return unmarshalExpandable(data, &r.ID, &r.Expanded, (*refund)(r))
 }
@@ -4,9 +4,9 @@ package refund
 import (
 	"fmt"
 	"net/url"
-	"strconv"
 
 	stripe "github.com/channelmeter/stripe-go"
+	"github.com/channelmeter/stripe-go/form"
 )
 
 const (
@@ -19,6 +19,9 @@ const (
 type Client struct {
 	B   stripe.Backend
 	Key string
+	// NoValidate disables the client-side validation of params
+	// that is otherwise done before calling the backend.
+	NoValidate bool
 }
 
 // New refunds a charge previously created.
@@ -28,25 +31,12 @@ func New(params *stripe.RefundParams) (*stripe.Refund, error) {
 }
 
 func (c Client) New(params *stripe.RefundParams) (*stripe.Refund, error) {
-	body := &url.Values{}
-
-	if params.Amount > 0 {
-		body.Add("amount", strconv.FormatUint(params.Amount, 10))
-	}
-
-	if params.Fee {
-		body.Add("refund_application_fee", strconv.FormatBool(params.Fee))
+	if err := stripe.ValidateParams(params, c.NoValidate); err != nil {
+		return nil, err
 	}
 
-	if params.Transfer {
-		body.Add("refund_transfer", strconv.FormatBool(params.Transfer))
-	}
-
-	if len(params.Reason) > 0 {
-		body.Add("reason", string(params.Reason))
-	}
-
-	params.AppendTo(body)
+	body := &url.Values{}
+	form.AppendTo(body, params)
 
 	refund := &stripe.Refund{}
 	err := c.B.Call("POST", fmt.Sprintf("/charges/%v/refunds", params.Charge), c.Key, body, &params.Params, refund)
@@ -81,6 +71,10 @@ func Update(id string, params *stripe.RefundParams) (*stripe.Refund, error) {
 }
 
 func (c Client) Update(id string, params *stripe.RefundParams) (*stripe.Refund, error) {
+	if err := stripe.ValidateParams(params, c.NoValidate); err != nil {
+		return nil, err
+	}
+
 	body := &url.Values{}
 
 	params.AppendTo(body)
@@ -98,15 +92,44 @@ func List(params *stripe.RefundListParams) *Iter {
 }
 
 func (c Client) List(params *stripe.RefundListParams) *Iter {
+	if err := stripe.ValidateParams(params, c.NoValidate); err != nil {
+		return &Iter{stripe.GetIterErr(err)}
+	}
+
 	body := &url.Values{}
 	var lp *stripe.ListParams
 
-	params.AppendTo(body)
+	form.AppendTo(body, params)
 	lp = &params.ListParams
 
-	return &Iter{stripe.GetIter(lp, body, func(b url.Values) ([]interface{}, stripe.ListMeta, error) {
+	return &Iter{stripe.GetIter(lp, body, c.query(params.Charge))}
+}
+
+// ListFrom returns all the refunds of a charge, starting with the ones
+// embedded in it and fetching the remaining pages as needed.
+func ListFrom(ch *stripe.Charge) *Iter {
+	return getC().ListFrom(ch)
+}
+
+func (c Client) ListFrom(ch *stripe.Charge) *Iter {
+	list := ch.Refunds
+	if list == nil {
+		list = &stripe.RefundList{ListMeta: stripe.ListMeta{More: true}}
+	}
+
+	values := make([]interface{}, len(list.Values))
+	for i, v := range list.Values {
+		values[i] = v
+	}
+
+	return &Iter{stripe.GetIterFrom(nil, nil, values, list.ListMeta, c.query(ch.ID))}
+}
+
+// query returns the query fetching a page of refunds of a charge.
+func (c Client) query(chargeID string) stripe.Query {
+	return func(b url.Values) ([]interface{}, stripe.ListMeta, error) {
 		list := &stripe.RefundList{}
-		err := c.B.Call("GET", fmt.Sprintf("/charges/%v/refunds", params.Charge), c.Key, &b, nil, list)
+		err := c.B.Call("GET", fmt.Sprintf("/charges/%v/refunds", chargeID), c.Key, &b, nil, list)
 
 		ret := make([]interface{}, len(list.Values))
 		for i, v := range list.Values {
@@ -114,7 +137,7 @@ func (c Client) List(params *stripe.RefundListParams) *Iter {
 		}
 
 		return ret, list.ListMeta, err
-	})}
+	}
 }
 
 // Iter is an iterator for lists of Refunds.
@@ -131,5 +154,5 @@ func (i *Iter) Refund() *stripe.Refund {
 }
 
 func getC() Client {
-	return Client{stripe.GetBackend(stripe.APIBackend), stripe.Key}
+	return Client{B: stripe.GetBackend(stripe.APIBackend), Key: stripe.Key}
 }
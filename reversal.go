@@ -1,13 +1,19 @@
 package stripe
 
-import "encoding/json"
-
 // ReversalParams is the set of parameters that can be used when reversing a transfer.
 type ReversalParams struct {
 	Params
-	Transfer string
-	Amount   uint64
-	Fee      bool
+	Transfer string `form:"-"`
+	Amount   uint64 `form:"amount"`
+	Fee      bool   `form:"refund_application_fee"`
+}
+
+// Validate checks the reversal parameters before they are sent.
+func (p *ReversalParams) Validate() error {
+	v := &validation{}
+	p.Params.validate(v)
+	v.required("transfer", p.Transfer)
+	return v.err()
 }
 
 // ReversalListParams is the set of parameters that can be used when listing reversals.
@@ -16,14 +22,24 @@ type ReversalListParams struct {
 	Transfer string
 }
 
+// Validate checks the reversal list parameters before they are sent.
+func (p *ReversalListParams) Validate() error {
+	v := &validation{}
+	p.ListParams.validate(v)
+	v.required("transfer", p.Transfer)
+	return v.err()
+}
+
 // Reversal represents a transfer reversal.
 type Reversal struct {
+	Extra
 	ID       string            `json:"id"`
 	Amount   uint64            `json:"amount"`
-	Created  int64             `json:"created"`
+	Created  Timestamp         `json:"created"`
 	Currency Currency          `json:"currency"`
 	Transfer string            `json:"transfer"`
 	Meta     map[string]string `json:"metadata"`
+	Expanded bool              `json:"-"`
 }
 
 // ReversalList is a list of object for reversals.
@@ -37,14 +53,5 @@ type ReversalList struct {
 // property may be an id or the full struct if it was expanded.
 func (r *Reversal) UnmarshalJSON(data []byte) error {
 	type reversal Reversal
-	var rr reversal
-	err := json.Unmarshal(data, &rr)
-	if err == nil {
-		*r = Reversal(rr)
-	} else {
-		// the id is surrounded by "\" characters, so strip them
-		r.ID = string(data[1 : len(data)-1])
-	}
-
-	return nil
+	return unmarshalExpandable(data, &r.ID, &r.Expanded, (*reversal)(r))
 }
@@ -4,15 +4,18 @@ package reversal
 import (
 	"fmt"
 	"net/url"
-	"strconv"
 
 	stripe "github.com/channelmeter/stripe-go"
+	"github.com/channelmeter/stripe-go/form"
 )
 
 // Client is used to invoke /transfers/reversals APIs.
 type Client struct {
 	B   stripe.Backend
 	Key string
+	// NoValidate disables the client-side validation of params
+	// that is otherwise done before calling the backend.
+	NoValidate bool
 }
 
 // New POSTs a new transfer reversal.
@@ -21,17 +24,12 @@ func New(params *stripe.ReversalParams) (*stripe.Reversal, error) {
 }
 
 func (c Client) New(params *stripe.ReversalParams) (*stripe.Reversal, error) {
-	body := &url.Values{}
-
-	if params.Amount > 0 {
-		body.Add("amount", strconv.FormatUint(params.Amount, 10))
-	}
-
-	if params.Fee {
-		body.Add("refund_application_fee", strconv.FormatBool(params.Fee))
+	if err := stripe.ValidateParams(params, c.NoValidate); err != nil {
+		return nil, err
 	}
 
-	params.AppendTo(body)
+	body := &url.Values{}
+	form.AppendTo(body, params)
 
 	reversal := &stripe.Reversal{}
 	err := c.B.Call("POST", fmt.Sprintf("/transfers/%v/reversals", params.Transfer), c.Key, body, &params.Params, reversal)
@@ -64,6 +62,10 @@ func Update(id string, params *stripe.ReversalParams) (*stripe.Reversal, error)
 }
 
 func (c Client) Update(id string, params *stripe.ReversalParams) (*stripe.Reversal, error) {
+	if err := stripe.ValidateParams(params, c.NoValidate); err != nil {
+		return nil, err
+	}
+
 	body := &url.Values{}
 
 	params.AppendTo(body)
@@ -80,15 +82,44 @@ func List(params *stripe.ReversalListParams) *Iter {
 }
 
 func (c Client) List(params *stripe.ReversalListParams) *Iter {
+	if err := stripe.ValidateParams(params, c.NoValidate); err != nil {
+		return &Iter{stripe.GetIterErr(err)}
+	}
+
 	body := &url.Values{}
 	var lp *stripe.ListParams
 
-	params.AppendTo(body)
+	form.AppendTo(body, params)
 	lp = &params.ListParams
 
-	return &Iter{stripe.GetIter(lp, body, func(b url.Values) ([]interface{}, stripe.ListMeta, error) {
+	return &Iter{stripe.GetIter(lp, body, c.query(params.Transfer))}
+}
+
+// ListFrom returns all the reversals of a transfer, starting with the ones
+// embedded in it and fetching the remaining pages as needed.
+func ListFrom(t *stripe.Transfer) *Iter {
+	return getC().ListFrom(t)
+}
+
+func (c Client) ListFrom(t *stripe.Transfer) *Iter {
+	list := t.Reversals
+	if list == nil {
+		list = &stripe.ReversalList{ListMeta: stripe.ListMeta{More: true}}
+	}
+
+	values := make([]interface{}, len(list.Values))
+	for i, v := range list.Values {
+		values[i] = v
+	}
+
+	return &Iter{stripe.GetIterFrom(nil, nil, values, list.ListMeta, c.query(t.ID))}
+}
+
+// query returns the query fetching a page of reversals of a transfer.
+func (c Client) query(transferID string) stripe.Query {
+	return func(b url.Values) ([]interface{}, stripe.ListMeta, error) {
 		list := &stripe.ReversalList{}
-		err := c.B.Call("GET", fmt.Sprintf("/transfers/%v/reversals", params.Transfer), c.Key, &b, nil, list)
+		err := c.B.Call("GET", fmt.Sprintf("/transfers/%v/reversals", transferID), c.Key, &b, nil, list)
 
 		ret := make([]interface{}, len(list.Values))
 		for i, v := range list.Values {
@@ -96,7 +127,7 @@ func (c Client) List(params *stripe.ReversalListParams) *Iter {
 		}
 
 		return ret, list.ListMeta, err
-	})}
+	}
 }
 
 // Iter is an iterator for lists of Reversals.
@@ -113,5 +144,5 @@ func (i *Iter) Reversal() *stripe.Reversal {
 }
 
 func getC() Client {
-	return Client{stripe.GetBackend(stripe.APIBackend), stripe.Key}
+	return Client{B: stripe.GetBackend(stripe.APIBackend), Key: stripe.Key}
 }
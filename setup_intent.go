@@ -0,0 +1,102 @@
+package stripe
+
+// SetupIntentStatus is the list of allowed values for the setup intent's status.
+// Allowed values are "requires_payment_method", "requires_confirmation",
+// "requires_action", "processing", "canceled", "succeeded".
+type SetupIntentStatus string
+
+// SetupIntentUsage is the list of allowed values for how the payment method
+// of a setup intent is meant to be used.
+// Allowed values are "on_session", "off_session".
+type SetupIntentUsage string
+
+// SetupIntentCancellationReason is the list of allowed values for the cancellation reason.
+// Allowed values are "abandoned", "duplicate", "requested_by_customer".
+type SetupIntentCancellationReason string
+
+// SetupIntentParams is the set of parameters that can be used when creating or updating a setup intent.
+// For more details see https://stripe.com/docs/api#create_setup_intent and https://stripe.com/docs/api#update_setup_intent.
+type SetupIntentParams struct {
+	Params
+	Confirm            bool             `form:"confirm"`
+	Customer           string           `form:"customer"`
+	Desc               string           `form:"description"`
+	OnBehalfOf         string           `form:"on_behalf_of"`
+	PaymentMethod      string           `form:"payment_method"`
+	PaymentMethodTypes []string         `form:"payment_method_types"`
+	ReturnURL          string           `form:"return_url"`
+	Usage              SetupIntentUsage `form:"usage"`
+}
+
+// Validate checks the setup intent parameters before they are sent.
+func (p *SetupIntentParams) Validate() error {
+	v := &validation{}
+	p.Params.validate(v)
+	v.oneOf("usage", string(p.Usage), "on_session", "off_session")
+
+	if len(p.ReturnURL) > 0 && !p.Confirm {
+		v.add("return_url", "can only be set when confirm is set")
+	}
+
+	return v.err()
+}
+
+// SetupIntentConfirmParams is the set of parameters that can be used when confirming a setup intent.
+// For more details see https://stripe.com/docs/api#confirm_setup_intent.
+type SetupIntentConfirmParams struct {
+	Params
+	PaymentMethod string `form:"payment_method"`
+	ReturnURL     string `form:"return_url"`
+}
+
+// Validate checks the setup intent confirmation parameters before they are sent.
+func (p *SetupIntentConfirmParams) Validate() error {
+	v := &validation{}
+	p.Params.validate(v)
+	return v.err()
+}
+
+// SetupIntentCancelParams is the set of parameters that can be used when canceling a setup intent.
+// For more details see https://stripe.com/docs/api#cancel_setup_intent.
+type SetupIntentCancelParams struct {
+	Params
+	Reason SetupIntentCancellationReason `form:"cancellation_reason"`
+}
+
+// Validate checks the setup intent cancellation parameters before they are sent.
+func (p *SetupIntentCancelParams) Validate() error {
+	v := &validation{}
+	p.Params.validate(v)
+	v.oneOf("cancellation_reason", string(p.Reason), "abandoned", "duplicate", "requested_by_customer")
+	return v.err()
+}
+
+// SetupIntent is the resource representing a Stripe setup intent.
+// For more details see https://stripe.com/docs/api#setup_intents.
+type SetupIntent struct {
+	Extra
+	ID                 string                         `json:"id"`
+	Live               bool                           `json:"livemode"`
+	CancellationReason SetupIntentCancellationReason  `json:"cancellation_reason"`
+	ClientSecret       string                         `json:"client_secret"`
+	Created            Timestamp                      `json:"created"`
+	Customer           *Customer                      `json:"customer"`
+	Desc               string                         `json:"description"`
+	LastSetupError     *PaymentIntentLastPaymentError `json:"last_setup_error"`
+	Meta               map[string]string              `json:"metadata"`
+	NextAction         *PaymentIntentNextAction       `json:"next_action"`
+	OnBehalfOf         *Account                       `json:"on_behalf_of"`
+	PaymentMethod      *PaymentMethod                 `json:"payment_method"`
+	PaymentMethodTypes []string                       `json:"payment_method_types"`
+	Status             SetupIntentStatus              `json:"status"`
+	Usage              SetupIntentUsage               `json:"usage"`
+	Expanded           bool                           `json:"-"`
+}
+
+// UnmarshalJSON handles deserialization of a SetupIntent.
+// This custom unmarshaling is needed because the resulting
+// property may be an id or the full struct if it was expanded.
+func (s *SetupIntent) UnmarshalJSON(data []byte) error {
+	type setupIntent SetupIntent
+	return unmarshalExpandable(data, &s.ID, &s.Expanded, (*setupIntent)(s))
+}
@@ -0,0 +1,159 @@
+// Package setupintent provides the /setup_intents APIs
+package setupintent
+
+import (
+	"fmt"
+	"net/url"
+
+	stripe "github.com/channelmeter/stripe-go"
+	"github.com/channelmeter/stripe-go/form"
+)
+
+const (
+	RequiresPaymentMethod stripe.SetupIntentStatus = "requires_payment_method"
+	RequiresConfirmation  stripe.SetupIntentStatus = "requires_confirmation"
+	RequiresAction        stripe.SetupIntentStatus = "requires_action"
+	Processing            stripe.SetupIntentStatus = "processing"
+	Canceled              stripe.SetupIntentStatus = "canceled"
+	Succeeded             stripe.SetupIntentStatus = "succeeded"
+
+	OnSession  stripe.SetupIntentUsage = "on_session"
+	OffSession stripe.SetupIntentUsage = "off_session"
+
+	CancelAbandoned           stripe.SetupIntentCancellationReason = "abandoned"
+	CancelDuplicate           stripe.SetupIntentCancellationReason = "duplicate"
+	CancelRequestedByCustomer stripe.SetupIntentCancellationReason = "requested_by_customer"
+)
+
+// Client is used to invoke /setup_intents APIs.
+type Client struct {
+	B   stripe.Backend
+	Key string
+	// NoValidate disables the client-side validation of params
+	// that is otherwise done before calling the backend.
+	NoValidate bool
+}
+
+// New POSTs a new setup intent.
+// For more details see https://stripe.com/docs/api#create_setup_intent.
+func New(params *stripe.SetupIntentParams) (*stripe.SetupIntent, error) {
+	return getC().New(params)
+}
+
+func (c Client) New(params *stripe.SetupIntentParams) (*stripe.SetupIntent, error) {
+	if err := stripe.ValidateParams(params, c.NoValidate); err != nil {
+		return nil, err
+	}
+
+	body := &url.Values{}
+	form.AppendTo(body, params)
+
+	intent := &stripe.SetupIntent{}
+	err := c.B.Call("POST", "/setup_intents", c.Key, body, stripe.CurrentVersion(&params.Params), intent)
+
+	return intent, err
+}
+
+// Get returns the details of a setup intent.
+// For more details see https://stripe.com/docs/api#retrieve_setup_intent.
+func Get(id string, params *stripe.SetupIntentParams) (*stripe.SetupIntent, error) {
+	return getC().Get(id, params)
+}
+
+func (c Client) Get(id string, params *stripe.SetupIntentParams) (*stripe.SetupIntent, error) {
+	var body *url.Values
+	var commonParams *stripe.Params
+
+	if params != nil {
+		commonParams = &params.Params
+		body = &url.Values{}
+		params.AppendTo(body)
+	}
+
+	intent := &stripe.SetupIntent{}
+	err := c.B.Call("GET", "/setup_intents/"+id, c.Key, body, stripe.CurrentVersion(commonParams), intent)
+
+	return intent, err
+}
+
+// Update updates a setup intent's properties.
+// For more details see https://stripe.com/docs/api#update_setup_intent.
+func Update(id string, params *stripe.SetupIntentParams) (*stripe.SetupIntent, error) {
+	return getC().Update(id, params)
+}
+
+func (c Client) Update(id string, params *stripe.SetupIntentParams) (*stripe.SetupIntent, error) {
+	if err := stripe.ValidateParams(params, c.NoValidate); err != nil {
+		return nil, err
+	}
+
+	var body *url.Values
+	var commonParams *stripe.Params
+
+	if params != nil {
+		commonParams = &params.Params
+		body = &url.Values{}
+		form.AppendTo(body, params)
+	}
+
+	intent := &stripe.SetupIntent{}
+	err := c.B.Call("POST", "/setup_intents/"+id, c.Key, body, stripe.CurrentVersion(commonParams), intent)
+
+	return intent, err
+}
+
+// Confirm confirms that the customer intends to save the payment method of the setup intent.
+// For more details see https://stripe.com/docs/api#confirm_setup_intent.
+func Confirm(id string, params *stripe.SetupIntentConfirmParams) (*stripe.SetupIntent, error) {
+	return getC().Confirm(id, params)
+}
+
+func (c Client) Confirm(id string, params *stripe.SetupIntentConfirmParams) (*stripe.SetupIntent, error) {
+	if err := stripe.ValidateParams(params, c.NoValidate); err != nil {
+		return nil, err
+	}
+
+	var body *url.Values
+	var commonParams *stripe.Params
+
+	if params != nil {
+		commonParams = &params.Params
+		body = &url.Values{}
+		form.AppendTo(body, params)
+	}
+
+	intent := &stripe.SetupIntent{}
+	err := c.B.Call("POST", fmt.Sprintf("/setup_intents/%v/confirm", id), c.Key, body, stripe.CurrentVersion(commonParams), intent)
+
+	return intent, err
+}
+
+// Cancel cancels a setup intent.
+// For more details see https://stripe.com/docs/api#cancel_setup_intent.
+func Cancel(id string, params *stripe.SetupIntentCancelParams) (*stripe.SetupIntent, error) {
+	return getC().Cancel(id, params)
+}
+
+func (c Client) Cancel(id string, params *stripe.SetupIntentCancelParams) (*stripe.SetupIntent, error) {
+	if err := stripe.ValidateParams(params, c.NoValidate); err != nil {
+		return nil, err
+	}
+
+	var body *url.Values
+	var commonParams *stripe.Params
+
+	if params != nil {
+		commonParams = &params.Params
+		body = &url.Values{}
+		form.AppendTo(body, params)
+	}
+
+	intent := &stripe.SetupIntent{}
+	err := c.B.Call("POST", fmt.Sprintf("/setup_intents/%v/cancel", id), c.Key, body, stripe.CurrentVersion(commonParams), intent)
+
+	return intent, err
+}
+
+func getC() Client {
+	return Client{B: stripe.GetBackend(stripe.APIBackend), Key: stripe.Key}
+}
@@ -0,0 +1,67 @@
+package setupintent
+
+import (
+	"testing"
+
+	stripe "github.com/channelmeter/stripe-go"
+	"github.com/channelmeter/stripe-go/customer"
+	. "github.com/channelmeter/stripe-go/utils"
+)
+
+func init() {
+	stripe.Key = GetTestKey()
+}
+
+func TestSetupIntentNewConfirm(t *testing.T) {
+	cust, _ := customer.New(nil)
+
+	params := &stripe.SetupIntentParams{
+		Customer:           cust.ID,
+		PaymentMethodTypes: []string{"card"},
+		Usage:              OffSession,
+	}
+
+	target, err := New(params)
+
+	if err != nil {
+		t.Error(err)
+	}
+
+	if target.Status != RequiresPaymentMethod {
+		t.Errorf("Status %q does not match expected status %q\n", target.Status, RequiresPaymentMethod)
+	}
+
+	if target.Usage != OffSession {
+		t.Errorf("Usage %q does not match expected usage %q\n", target.Usage, OffSession)
+	}
+
+	target, err = Confirm(target.ID, &stripe.SetupIntentConfirmParams{PaymentMethod: "pm_card_visa"})
+
+	if err != nil {
+		t.Error(err)
+	}
+
+	if target.Status != Succeeded {
+		t.Errorf("Status %q does not match expected status %q\n", target.Status, Succeeded)
+	}
+
+	if target.PaymentMethod == nil {
+		t.Errorf("Payment method is not set\n")
+	}
+
+	customer.Del(cust.ID)
+}
+
+func TestSetupIntentCancel(t *testing.T) {
+	intent, _ := New(&stripe.SetupIntentParams{PaymentMethodTypes: []string{"card"}})
+
+	target, err := Cancel(intent.ID, &stripe.SetupIntentCancelParams{Reason: CancelAbandoned})
+
+	if err != nil {
+		t.Error(err)
+	}
+
+	if target.Status != Canceled {
+		t.Errorf("Status %q does not match expected status %q\n", target.Status, Canceled)
+	}
+}
@@ -0,0 +1,205 @@
+package stripe
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+// ShardParams splits a list into windows of its range filter that are
+// fetched concurrently. The range, such as a CreatedRange, must have both a
+// lower and an upper bound. Items are still returned in the order of the
+// API, from the most recent window to the oldest one.
+type ShardParams struct {
+	// Key is the name of the range filter to split, "created" by default.
+	Key string
+	// Count is the number of windows the range is split into.
+	// It defaults to 1.
+	Count int
+	// Workers is the maximum number of windows fetched at the same time.
+	// It defaults to Count.
+	Workers int
+}
+
+// page is a page of results as returned by a Query.
+type page struct {
+	values []interface{}
+	meta   ListMeta
+	err    error
+}
+
+// shard fetches the pages of a window in the background.
+type shard struct {
+	qs    url.Values
+	pages chan page
+}
+
+// validate checks the shard parameters.
+func (s *ShardParams) validate(v *validation) {
+	if s.Count < 1 {
+		v.add("shard", "count must be at least 1")
+	}
+
+	if s.Workers < 0 {
+		v.add("shard", "workers must not be negative")
+	}
+}
+
+// key returns the name of the range filter to split.
+func (s *ShardParams) key() string {
+	if len(s.Key) == 0 {
+		return "created"
+	}
+
+	return s.Key
+}
+
+// startShards splits the range filter of the query string into windows and
+// starts fetching them in the background.
+func (it *Iter) startShards() error {
+	key := it.params.Shard.key()
+
+	lower, upper, err := shardRange(it.qs, key)
+	if err != nil {
+		return err
+	}
+
+	// The params may not have been validated, so bad values fall back to
+	// the defaults rather than dividing by zero.
+	count := int64(it.params.Shard.Count)
+	if count < 1 {
+		count = 1
+	}
+	if count > upper-lower {
+		count = upper - lower
+	}
+
+	size := (upper - lower + count - 1) / count
+	it.done = make(chan struct{})
+
+	for hi := upper; hi > lower; hi -= size {
+		lo := hi - size
+		if lo < lower {
+			lo = lower
+		}
+
+		qs := url.Values{}
+		for k, v := range it.qs {
+			qs[k] = v
+		}
+		for _, op := range []string{"gt", "gte", "lt", "lte"} {
+			qs.Del(key + "[" + op + "]")
+		}
+		qs.Set(key+"[gte]", strconv.FormatInt(lo, 10))
+		qs.Set(key+"[lt]", strconv.FormatInt(hi, 10))
+
+		it.shards = append(it.shards, &shard{qs: qs, pages: make(chan page, 1)})
+	}
+
+	workers := it.params.Shard.Workers
+	if workers < 1 || workers > len(it.shards) {
+		workers = len(it.shards)
+	}
+
+	go it.runShards(workers)
+	return nil
+}
+
+// runShards fetches the windows in order, with at most workers of them
+// fetched at the same time. As windows are consumed in the same order, the
+// window being consumed always holds a worker.
+func (it *Iter) runShards(workers int) {
+	sem := make(chan struct{}, workers)
+
+	for _, s := range it.shards {
+		select {
+		case sem <- struct{}{}:
+		case <-it.done:
+			return
+		}
+
+		go func(s *shard) {
+			defer func() { <-sem }()
+			s.fetch(it.query, it.done)
+		}(s)
+	}
+}
+
+// fetch sends all the pages of the window until the last one, an error or
+// until done is closed.
+func (s *shard) fetch(query Query, done chan struct{}) {
+	defer close(s.pages)
+
+	for {
+		values, meta, err := query(s.qs)
+
+		select {
+		case s.pages <- page{values, meta, err}:
+		case <-done:
+			return
+		}
+
+		if err != nil || !meta.More || len(values) == 0 {
+			return
+		}
+
+		s.qs.Set(startafter, listItemID(values[len(values)-1]))
+	}
+}
+
+// nextShardPage returns the next non-empty page of the windows, moving on
+// to the next window when one is exhausted.
+func (it *Iter) nextShardPage() page {
+	for len(it.shards) > 0 {
+		p, ok := <-it.shards[0].pages
+		if !ok {
+			it.shards = it.shards[1:]
+			continue
+		}
+
+		if p.err != nil || len(p.values) > 0 {
+			// More pages may follow in this window or the next ones.
+			p.meta.More = p.err == nil
+			return p
+		}
+	}
+
+	return page{}
+}
+
+// shardRange returns the bounds of the range filter key in the query
+// string, as Unix times with the lower bound included and the upper one
+// excluded.
+func shardRange(qs url.Values, key string) (lower, upper int64, err error) {
+	bound := func(op string, shift int64) (int64, bool, error) {
+		s := qs.Get(key + "[" + op + "]")
+		if len(s) == 0 {
+			return 0, false, nil
+		}
+
+		n, err := strconv.ParseInt(s, 10, 64)
+		return n + shift, true, err
+	}
+
+	lower, ok, err := bound("gte", 0)
+	if err == nil && !ok {
+		lower, ok, err = bound("gt", 1)
+	}
+	if err != nil || !ok {
+		return 0, 0, fmt.Errorf("Sharded list needs a lower bound for %v", key)
+	}
+
+	upper, ok, err = bound("lt", 0)
+	if err == nil && !ok {
+		upper, ok, err = bound("lte", 1)
+	}
+	if err != nil || !ok {
+		return 0, 0, fmt.Errorf("Sharded list needs an upper bound for %v", key)
+	}
+
+	if upper <= lower {
+		return 0, 0, fmt.Errorf("Sharded list needs a non-empty range for %v", key)
+	}
+
+	return lower, upper, nil
+}
@@ -0,0 +1,167 @@
+package stripe
+
+import (
+	"net/url"
+	"reflect"
+	"strconv"
+	"sync"
+	"testing"
+)
+
+// windowQuery serves two pages for each window of created, the first one
+// holding "<lower>a" and the second one "<lower>b", except for the window
+// starting at empty which has no items at all.
+type windowQuery struct {
+	mu    sync.Mutex
+	calls int
+	empty string
+}
+
+func (wq *windowQuery) query(qs url.Values) ([]interface{}, ListMeta, error) {
+	wq.mu.Lock()
+	wq.calls++
+	wq.mu.Unlock()
+
+	lower := qs.Get("created[gte]")
+	switch {
+	case lower == wq.empty:
+		return nil, ListMeta{}, nil
+	case len(qs.Get(startafter)) == 0:
+		return []interface{}{&item{lower + "a"}}, ListMeta{0, true, ""}, nil
+	default:
+		return []interface{}{&item{lower + "b"}}, ListMeta{}, nil
+	}
+}
+
+func ids(values []interface{}) []string {
+	ids := make([]string, len(values))
+	for i, v := range values {
+		ids[i] = v.(*item).ID
+	}
+	return ids
+}
+
+func TestIterShards(t *testing.T) {
+	wq := &windowQuery{empty: "25"}
+	qs := &url.Values{"created[gte]": {"0"}, "created[lte]": {"99"}}
+	params := &ListParams{Shard: &ShardParams{Count: 4, Workers: 2}}
+
+	g, gerr := collect(GetIter(params, qs, wq.query))
+	want := []string{"75a", "75b", "50a", "50b", "0a", "0b"}
+	if !reflect.DeepEqual(ids(g), want) {
+		t.Fatalf("results = %v want %v", ids(g), want)
+	}
+	if gerr != nil {
+		t.Fatalf("err = %v want nil", gerr)
+	}
+	if wq.calls != 7 {
+		t.Fatalf("calls = %v want 7", wq.calls)
+	}
+}
+
+func TestIterShardsSmallRange(t *testing.T) {
+	wq := &windowQuery{}
+	qs := &url.Values{"created[gt]": {"9"}, "created[lt]": {"12"}}
+	params := &ListParams{Shard: &ShardParams{Count: 10}}
+
+	g, gerr := collect(GetIter(params, qs, wq.query))
+	want := []string{"11a", "11b", "10a", "10b"}
+	if !reflect.DeepEqual(ids(g), want) {
+		t.Fatalf("results = %v want %v", ids(g), want)
+	}
+	if gerr != nil {
+		t.Fatalf("err = %v want nil", gerr)
+	}
+}
+
+func TestIterShardsUnvalidated(t *testing.T) {
+	wq := &windowQuery{}
+	qs := &url.Values{"created[gte]": {"0"}, "created[lt]": {"10"}}
+	params := &ListParams{Shard: &ShardParams{Count: 0, Workers: -1}}
+
+	g, gerr := collect(GetIter(params, qs, wq.query))
+	want := []string{"0a", "0b"}
+	if !reflect.DeepEqual(ids(g), want) {
+		t.Fatalf("results = %v want %v", ids(g), want)
+	}
+	if gerr != nil {
+		t.Fatalf("err = %v want nil", gerr)
+	}
+}
+
+func TestIterShardsErr(t *testing.T) {
+	query := func(qs url.Values) ([]interface{}, ListMeta, error) {
+		if qs.Get("created[gte]") == "50" {
+			return nil, ListMeta{}, errTest
+		}
+
+		lower, _ := strconv.Atoi(qs.Get("created[gte]"))
+		return []interface{}{lower}, ListMeta{}, nil
+	}
+
+	qs := &url.Values{"created[gte]": {"0"}, "created[lt]": {"100"}}
+	it := GetIter(&ListParams{Shard: &ShardParams{Count: 4}}, qs, query)
+	defer it.Close()
+
+	g, gerr := collect(it)
+	want := []interface{}{75}
+	if !reflect.DeepEqual(g, want) {
+		t.Fatalf("results = %v want %v", g, want)
+	}
+	if gerr != errTest {
+		t.Fatalf("err = %v want %v", gerr, errTest)
+	}
+}
+
+func TestIterShardsNeedRange(t *testing.T) {
+	query := func(url.Values) ([]interface{}, ListMeta, error) {
+		t.Fatalf("query called without a range")
+		return nil, ListMeta{}, nil
+	}
+
+	qs := &url.Values{"created[gte]": {"0"}}
+	g, gerr := collect(GetIter(&ListParams{Shard: &ShardParams{Count: 4}}, qs, query))
+	if len(g) != 0 {
+		t.Fatalf("results = %v want empty", g)
+	}
+	if gerr == nil {
+		t.Fatalf("err = nil want an error")
+	}
+}
+
+func TestIterPrefetch(t *testing.T) {
+	var mu sync.Mutex
+	var starts []string
+	query := func(qs url.Values) ([]interface{}, ListMeta, error) {
+		mu.Lock()
+		starts = append(starts, qs.Get(startafter))
+		mu.Unlock()
+
+		if len(qs.Get(startafter)) == 0 {
+			return []interface{}{&item{"a"}, &item{"b"}}, ListMeta{0, true, ""}, nil
+		}
+		return []interface{}{&item{"c"}}, ListMeta{}, nil
+	}
+
+	it := GetIter(&ListParams{Prefetch: true}, nil, query)
+	if !it.Next() {
+		t.Fatalf("Next() = false want true")
+	}
+
+	// the second page is fetched before the first one is consumed
+	p := <-it.next
+	it.next = make(chan page, 1)
+	it.next <- p
+
+	g, gerr := collect(it)
+	want := []string{"b", "c"}
+	if !reflect.DeepEqual(ids(g), want) {
+		t.Fatalf("results = %v want %v", ids(g), want)
+	}
+	if !reflect.DeepEqual(starts, []string{"", "b"}) {
+		t.Fatalf("starting_after = %v want [ b]", starts)
+	}
+	if gerr != nil {
+		t.Fatalf("err = %v want nil", gerr)
+	}
+}
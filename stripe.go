@@ -22,6 +22,11 @@ const (
 // apiversion is the currently supported API version
 const apiversion = "2015-02-18"
 
+// CurrentAPIVersion is the API version used by the resources that apiversion
+// returns in an older shape or not at all, such as PaymentIntents, whose
+// next_action and statuses were renamed since; see CurrentVersion.
+const CurrentAPIVersion = "2020-08-27"
+
 // clientversion is the binding version
 const clientversion = "6.1.0"
 
@@ -170,6 +175,8 @@ func (s *BackendConfiguration) NewRequest(method, path, key, contentType string,
 
 	req.SetBasicAuth(key, "")
 
+	version := apiversion
+
 	if params != nil {
 		if idempotency := strings.TrimSpace(params.IdempotencyKey); idempotency != "" {
 			if len(idempotency) > 255 {
@@ -182,9 +189,13 @@ func (s *BackendConfiguration) NewRequest(method, path, key, contentType string,
 		if account := strings.TrimSpace(params.Account); account != "" {
 			req.Header.Add("Stripe-Account", account)
 		}
+
+		if len(params.Version) > 0 {
+			version = params.Version
+		}
 	}
 
-	req.Header.Add("Stripe-Version", apiversion)
+	req.Header.Add("Stripe-Version", version)
 	req.Header.Add("User-Agent", "Stripe/v1 GoBindings/"+clientversion)
 	req.Header.Add("Content-Type", contentType)
 
@@ -215,59 +226,78 @@ func (s *BackendConfiguration) Do(req *http.Request, v interface{}) error {
 	}
 	defer res.Body.Close()
 
-	resBody, err := ioutil.ReadAll(res.Body)
-	if err != nil {
-		if LogLevel > 0 {
-			log.Printf("Cannot parse Stripe response: %v\n", err)
-		}
-		return err
+	if res.StatusCode >= 400 {
+		return responseError(res)
 	}
 
-	if res.StatusCode >= 400 {
-		// for some odd reason, the Erro structure doesn't unmarshal
-		// initially I thought it was because it's a struct inside of a struct
-		// but even after trying that, it still didn't work
-		// so unmarshalling to a map for now and parsing the results manually
-		// but should investigate later
-		var errMap map[string]interface{}
-		json.Unmarshal(resBody, &errMap)
-
-		if e, found := errMap["error"]; !found {
-			err := errors.New(string(resBody))
+	if LogLevel > 2 {
+		buf := getBuffer()
+		defer putBuffer(buf)
+
+		if _, err := buf.ReadFrom(res.Body); err != nil {
 			if LogLevel > 0 {
-				log.Printf("Unparsable error returned from Stripe: %v\n", err)
+				log.Printf("Cannot read Stripe response: %v\n", err)
 			}
 			return err
-		} else {
-			root := e.(map[string]interface{})
-			err := &Error{
-				Type:           ErrorType(root["type"].(string)),
-				Msg:            root["message"].(string),
-				HTTPStatusCode: res.StatusCode,
-			}
+		}
 
-			if code, found := root["code"]; found {
-				err.Code = ErrorCode(code.(string))
-			}
+		log.Printf("Stripe Response: %q\n", buf.Bytes())
 
-			if param, found := root["param"]; found {
-				err.Param = param.(string)
-			}
+		if v != nil {
+			return json.Unmarshal(buf.Bytes(), v)
+		}
+		return nil
+	}
 
-			if LogLevel > 0 {
-				log.Printf("Error encountered from Stripe: %v\n", err)
-			}
-			return err
+	if v == nil {
+		// drain the body so that the connection can be reused
+		_, err := io.Copy(ioutil.Discard, res.Body)
+		return err
+	}
+
+	// decode the response as it is read rather than buffering it first
+	if err := json.NewDecoder(res.Body).Decode(v); err != nil {
+		if LogLevel > 0 {
+			log.Printf("Cannot parse Stripe response: %v\n", err)
 		}
+		return err
 	}
 
-	if LogLevel > 2 {
-		log.Printf("Stripe Response: %q\n", resBody)
+	// drain what follows the decoded value, such as a trailing newline,
+	// so that the connection can be reused
+	_, err = io.Copy(ioutil.Discard, res.Body)
+	return err
+}
+
+// responseError returns the error held by an unsuccessful response.
+func responseError(res *http.Response) error {
+	buf := getBuffer()
+	defer putBuffer(buf)
+
+	if _, err := buf.ReadFrom(res.Body); err != nil {
+		if LogLevel > 0 {
+			log.Printf("Cannot read Stripe response: %v\n", err)
+		}
+		return err
 	}
 
-	if v != nil {
-		return json.Unmarshal(resBody, v)
+	var body struct {
+		Error *Error `json:"error"`
 	}
 
-	return nil
+	if err := json.Unmarshal(buf.Bytes(), &body); err != nil || body.Error == nil {
+		err := errors.New(buf.String())
+		if LogLevel > 0 {
+			log.Printf("Unparsable error returned from Stripe: %v\n", err)
+		}
+		return err
+	}
+
+	err := body.Error
+	err.HTTPStatusCode = res.StatusCode
+
+	if LogLevel > 0 {
+		log.Printf("Error encountered from Stripe: %v\n", err)
+	}
+	return err
 }
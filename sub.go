@@ -1,22 +1,86 @@
 package stripe
 
-import "encoding/json"
+import (
+	"net/url"
+
+	"github.com/channelmeter/stripe-go/form"
+)
 
 // SubStatus is the list of allowed values for the subscription's status.
 // Allowed values are "trialing", "active", "past_due", "canceled", "unpaid".
 type SubStatus string
 
 // SubParams is the set of parameters that can be used when creating or updating a subscription.
+// DefaultTaxRates replaces TaxPercent and applies to the items without tax rates of their own;
+// the tax rates are removed with SetZero("default_tax_rates"). The API only takes prices as
+// items, so when creating a subscription Price and Quantity are sent as its single item.
+// Price cannot be used when updating, as it would add a second item: change the price of
+// the existing item through Items with its ID instead.
 // For more details see https://stripe.com/docs/api#create_subscription and https://stripe.com/docs/api#update_subscription.
 type SubParams struct {
 	Params
-	Customer, Plan                                  string
-	Coupon, Token                                   string
-	TrialEnd                                        int64
-	Card                                            *CardParams
-	Quantity                                        uint64
-	FeePercent, TaxPercent                          float64
-	NoProrate, EndCancel, QuantityZero, TrialEndNow bool
+	Customer        string            `form:"-"`
+	Plan            string            `form:"plan"`
+	Price           string            `form:"-"`
+	Items           []*SubItemsParams `form:"items"`
+	Coupon          string            `form:"coupon"`
+	Token           string            `form:"-"`
+	TrialEnd        Timestamp         `form:"trial_end"`
+	Card            *CardParams       `form:"-"`
+	Quantity        uint64            `form:"quantity"`
+	FeePercent      float64           `form:"application_fee_percent"`
+	TaxPercent      float64           `form:"tax_percent"`
+	DefaultTaxRates []string          `form:"default_tax_rates"`
+	NoProrate       bool              `form:"prorate,invert"`
+	ProrationDate   Timestamp         `form:"proration_date"`
+	EndCancel       bool              `form:"at_period_end"`
+	TrialEndNow     bool              `form:"-"`
+	// Deprecated: use SetZero("quantity"); QuantityZero will be removed
+	// in the next release.
+	QuantityZero bool `form:"-"`
+}
+
+// Validate checks the subscription parameters before they are sent.
+func (s *SubParams) Validate() error {
+	v := &validation{}
+	s.Params.validate(v)
+	v.required("customer", s.Customer)
+	v.percent("application_fee_percent", s.FeePercent)
+	v.percent("tax_percent", s.TaxPercent)
+
+	if s.TaxPercent > 0 && len(s.DefaultTaxRates) > 0 {
+		v.add("default_tax_rates", "cannot be used together with tax_percent")
+	}
+
+	if len(s.Plan) > 0 && len(s.Price) > 0 {
+		v.add("price", "cannot be used together with a plan")
+	}
+
+	if len(s.Items) > 0 && (len(s.Plan) > 0 || len(s.Price) > 0) {
+		v.add("items", "cannot be used together with a plan or price")
+	}
+
+	for i, item := range s.Items {
+		item.validate(v, i)
+	}
+
+	if s.NoProrate && s.ProrationDate > 0 {
+		v.add("proration_date", "cannot be used when not prorating")
+	}
+
+	if s.TrialEndNow && s.TrialEnd > 0 {
+		v.add("trial_end", "cannot set a trial end together with TrialEndNow")
+	}
+
+	if len(s.Token) > 0 && s.Card != nil {
+		v.add("card", "cannot be used together with a token")
+	}
+
+	if s.Card != nil {
+		v.nested(s.Card.Validate())
+	}
+
+	return v.err()
 }
 
 // SubListParams is the set of parameters that can be used when listing active subscriptions.
@@ -26,25 +90,71 @@ type SubListParams struct {
 	Customer string
 }
 
+// AppendForm implements form.Appender for subscriptions, which send a token
+// in place of the card, a price as their first item and accept "now" as the
+// trial end. The deprecated QuantityZero is still sent as a zero quantity.
+func (s *SubParams) AppendForm(values *url.Values, keyParts []string) {
+	form.AppendFields(values, s, keyParts)
+
+	key := func(parts ...string) string {
+		return form.FormatKey(append(keyParts[:len(keyParts):len(keyParts)], parts...))
+	}
+
+	if s.QuantityZero && len(values.Get(key("quantity"))) == 0 {
+		values.Set(key("quantity"), "0")
+	}
+
+	if len(s.Price) > 0 {
+		values.Add(key("items", "0", "price"), s.Price)
+
+		if quantity := values.Get(key("quantity")); len(quantity) > 0 {
+			values.Del(key("quantity"))
+			values.Add(key("items", "0", "quantity"), quantity)
+		}
+	}
+
+	if len(s.Token) > 0 {
+		values.Add("card", s.Token)
+	} else if s.Card != nil {
+		s.Card.AppendForm(values, []string{"card"})
+	}
+
+	if s.TrialEndNow {
+		values.Set(key("trial_end"), "now")
+	}
+}
+
+// Validate checks the subscription list parameters before they are sent.
+func (p *SubListParams) Validate() error {
+	v := &validation{}
+	p.ListParams.validate(v)
+	v.required("customer", p.Customer)
+	return v.err()
+}
+
 // Sub is the resource representing a Stripe subscription.
 // For more details see https://stripe.com/docs/api#subscriptions.
 type Sub struct {
-	ID          string            `json:"id"`
-	EndCancel   bool              `json:"cancel_at_period_end"`
-	Customer    *Customer         `json:"customer"`
-	Plan        *Plan             `json:"plan"`
-	Quantity    uint64            `json:"quantity"`
-	Status      SubStatus         `json:"status"`
-	FeePercent  float64           `json:"application_fee_percent"`
-	Canceled    int64             `json:"canceled_at"`
-	PeriodEnd   int64             `json:"current_period_end"`
-	PeriodStart int64             `json:"current_period_start"`
-	Discount    *Discount         `json:"discount"`
-	Ended       int64             `json:"ended_at"`
-	Meta        map[string]string `json:"metadata"`
-	TaxPercent  float64           `json:"tax_percent"`
-	TrialEnd    int64             `json:"trial_end"`
-	TrialStart  int64             `json:"trial_start"`
+	Extra
+	ID              string            `json:"id"`
+	EndCancel       bool              `json:"cancel_at_period_end"`
+	Customer        *Customer         `json:"customer"`
+	Items           *SubItemList      `json:"items"`
+	Plan            *Plan             `json:"plan"`
+	Quantity        uint64            `json:"quantity"`
+	Status          SubStatus         `json:"status"`
+	FeePercent      float64           `json:"application_fee_percent"`
+	Canceled        Timestamp         `json:"canceled_at"`
+	PeriodEnd       Timestamp         `json:"current_period_end"`
+	PeriodStart     Timestamp         `json:"current_period_start"`
+	Discount        *Discount         `json:"discount"`
+	Ended           Timestamp         `json:"ended_at"`
+	Meta            map[string]string `json:"metadata"`
+	TaxPercent      float64           `json:"tax_percent"`
+	DefaultTaxRates []*TaxRate        `json:"default_tax_rates"`
+	TrialEnd        Timestamp         `json:"trial_end"`
+	TrialStart      Timestamp         `json:"trial_start"`
+	Expanded        bool              `json:"-"`
 }
 
 // SubList is a list object for subscriptions.
@@ -58,14 +168,5 @@ type SubList struct {
 // property may be an id or the full struct if it was expanded.
 func (s *Sub) UnmarshalJSON(data []byte) error {
 	type sub Sub
-	var ss sub
-	err := json.Unmarshal(data, &ss)
-	if err == nil {
-		*s = Sub(ss)
-	} else {
-		// the id is surrounded by "\" characters, so strip them
-		s.ID = string(data[1 : len(data)-1])
-	}
-
-	return nil
+	return unmarshalExpandable(data, &s.ID, &s.Expanded, (*sub)(s))
 }
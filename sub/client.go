@@ -2,11 +2,12 @@
 package sub
 
 import (
+	"errors"
 	"fmt"
 	"net/url"
-	"strconv"
 
 	stripe "github.com/channelmeter/stripe-go"
+	"github.com/channelmeter/stripe-go/form"
 )
 
 const (
@@ -21,6 +22,9 @@ const (
 type Client struct {
 	B   stripe.Backend
 	Key string
+	// NoValidate disables the client-side validation of params
+	// that is otherwise done before calling the backend.
+	NoValidate bool
 }
 
 // New POSTS a new subscription for a customer.
@@ -30,42 +34,14 @@ func New(params *stripe.SubParams) (*stripe.Sub, error) {
 }
 
 func (c Client) New(params *stripe.SubParams) (*stripe.Sub, error) {
-	body := &url.Values{
-		"plan": {params.Plan},
+	if err := stripe.ValidateParams(params, c.NoValidate); err != nil {
+		return nil, err
 	}
 
-	if len(params.Token) > 0 {
-		body.Add("card", params.Token)
-	} else if params.Card != nil {
-		params.Card.AppendDetails(body, true)
-	}
-
-	if len(params.Coupon) > 0 {
-		body.Add("coupon", params.Coupon)
-	}
-
-	if params.TrialEndNow {
-		body.Add("trial_end", "now")
-	} else if params.TrialEnd > 0 {
-		body.Add("trial_end", strconv.FormatInt(params.TrialEnd, 10))
-	}
-
-	if params.Quantity > 0 {
-		body.Add("quantity", strconv.FormatUint(params.Quantity, 10))
-	} else if params.QuantityZero {
-		body.Add("quantity", "0")
-	}
+	body := &url.Values{}
+	form.AppendTo(body, params)
 
 	token := c.Key
-	if params.FeePercent > 0 {
-		body.Add("application_fee_percent", strconv.FormatFloat(params.FeePercent, 'f', 2, 64))
-	}
-
-	if params.TaxPercent > 0 {
-		body.Add("tax_percent", strconv.FormatFloat(params.TaxPercent, 'f', 2, 64))
-	}
-
-	params.AppendTo(body)
 
 	sub := &stripe.Sub{}
 	err := c.B.Call("POST", fmt.Sprintf("/customers/%v/subscriptions", params.Customer), token, body, &params.Params, sub)
@@ -100,48 +76,18 @@ func Update(id string, params *stripe.SubParams) (*stripe.Sub, error) {
 }
 
 func (c Client) Update(id string, params *stripe.SubParams) (*stripe.Sub, error) {
-	body := &url.Values{}
-
-	if len(params.Plan) > 0 {
-		body.Add("plan", params.Plan)
-	}
-
-	if params.NoProrate {
-		body.Add("prorate", strconv.FormatBool(false))
-	}
-
-	if len(params.Token) > 0 {
-		body.Add("card", params.Token)
-	} else if params.Card != nil {
-		if len(params.Card.Token) > 0 {
-			body.Add("card", params.Card.Token)
-		} else {
-			params.Card.AppendDetails(body, true)
-		}
-	}
-
-	if len(params.Coupon) > 0 {
-		body.Add("coupon", params.Coupon)
+	if err := stripe.ValidateParams(params, c.NoValidate); err != nil {
+		return nil, err
 	}
 
-	if params.TrialEnd > 0 {
-		body.Add("trial_end", strconv.FormatInt(params.TrialEnd, 10))
+	if len(params.Price) > 0 {
+		return nil, errors.New("Invalid subscription params: price can only be set when creating a subscription, use items with the ID of the existing item instead")
 	}
 
-	if params.Quantity > 0 {
-		body.Add("quantity", strconv.FormatUint(params.Quantity, 10))
-	}
+	body := &url.Values{}
+	form.AppendTo(body, params)
 
 	token := c.Key
-	if params.FeePercent > 0 {
-		body.Add("application_fee_percent", strconv.FormatFloat(params.FeePercent, 'f', 2, 64))
-	}
-
-	if params.TaxPercent > 0 {
-		body.Add("tax_percent", strconv.FormatFloat(params.TaxPercent, 'f', 2, 64))
-	}
-
-	params.AppendTo(body)
 
 	sub := &stripe.Sub{}
 	err := c.B.Call("POST", fmt.Sprintf("/customers/%v/subscriptions/%v", params.Customer, id), token, body, &params.Params, sub)
@@ -157,12 +103,7 @@ func Cancel(id string, params *stripe.SubParams) error {
 
 func (c Client) Cancel(id string, params *stripe.SubParams) error {
 	body := &url.Values{}
-
-	if params.EndCancel {
-		body.Add("at_period_end", strconv.FormatBool(true))
-	}
-
-	params.AppendTo(body)
+	form.AppendTo(body, params)
 
 	return c.B.Call("DELETE", fmt.Sprintf("/customers/%v/subscriptions/%v", params.Customer, id), c.Key, body, &params.Params, nil)
 }
@@ -174,15 +115,44 @@ func List(params *stripe.SubListParams) *Iter {
 }
 
 func (c Client) List(params *stripe.SubListParams) *Iter {
+	if err := stripe.ValidateParams(params, c.NoValidate); err != nil {
+		return &Iter{stripe.GetIterErr(err)}
+	}
+
 	body := &url.Values{}
 	var lp *stripe.ListParams
 
-	params.AppendTo(body)
+	form.AppendTo(body, params)
 	lp = &params.ListParams
 
-	return &Iter{stripe.GetIter(lp, body, func(b url.Values) ([]interface{}, stripe.ListMeta, error) {
+	return &Iter{stripe.GetIter(lp, body, c.query(params.Customer))}
+}
+
+// ListFrom returns all the subscriptions of a customer, starting with the ones
+// embedded in it and fetching the remaining pages as needed.
+func ListFrom(cust *stripe.Customer) *Iter {
+	return getC().ListFrom(cust)
+}
+
+func (c Client) ListFrom(cust *stripe.Customer) *Iter {
+	list := cust.Subs
+	if list == nil {
+		list = &stripe.SubList{ListMeta: stripe.ListMeta{More: true}}
+	}
+
+	values := make([]interface{}, len(list.Values))
+	for i, v := range list.Values {
+		values[i] = v
+	}
+
+	return &Iter{stripe.GetIterFrom(nil, nil, values, list.ListMeta, c.query(cust.ID))}
+}
+
+// query returns the query fetching a page of subscriptions of a customer.
+func (c Client) query(customerID string) stripe.Query {
+	return func(b url.Values) ([]interface{}, stripe.ListMeta, error) {
 		list := &stripe.SubList{}
-		err := c.B.Call("GET", fmt.Sprintf("/customers/%v/subscriptions", params.Customer), c.Key, &b, nil, list)
+		err := c.B.Call("GET", fmt.Sprintf("/customers/%v/subscriptions", customerID), c.Key, &b, nil, list)
 
 		ret := make([]interface{}, len(list.Values))
 		for i, v := range list.Values {
@@ -190,7 +160,7 @@ func (c Client) List(params *stripe.SubListParams) *Iter {
 		}
 
 		return ret, list.ListMeta, err
-	})}
+	}
 }
 
 // Iter is an iterator for lists of Subs.
@@ -207,5 +177,5 @@ func (i *Iter) Sub() *stripe.Sub {
 }
 
 func getC() Client {
-	return Client{stripe.GetBackend(stripe.APIBackend), stripe.Key}
+	return Client{B: stripe.GetBackend(stripe.APIBackend), Key: stripe.Key}
 }
@@ -92,10 +92,10 @@ func TestSubscriptionZeroQuantity(t *testing.T) {
 	plan.New(planParams)
 
 	subParams := &stripe.SubParams{
-		Customer:     cust.ID,
-		Plan:         "test",
-		QuantityZero: true,
+		Customer: cust.ID,
+		Plan:     "test",
 	}
+	subParams.SetZero("quantity")
 
 	target, err := New(subParams)
 
@@ -255,6 +255,15 @@ func TestSubscriptionUpdate(t *testing.T) {
 	plan.Del("test")
 }
 
+func TestSubscriptionUpdatePrice(t *testing.T) {
+	params := &stripe.SubParams{Customer: "cus_123", Price: "price_123"}
+
+	_, err := Update("sub_123", params)
+	if err == nil {
+		t.Errorf("Updating the price of a subscription should have failed\n")
+	}
+}
+
 func TestSubscriptionDiscount(t *testing.T) {
 	couponParams := &stripe.CouponParams{
 		Duration: coupon.Forever,
@@ -0,0 +1,115 @@
+package stripe
+
+import "fmt"
+
+// SubItemParams is the set of parameters that can be used when creating, updating or deleting a subscription item.
+// The proration options apply to the change of the subscription made by the item.
+// For more details see https://stripe.com/docs/api#create_subscription_item and https://stripe.com/docs/api#update_subscription_item.
+type SubItemParams struct {
+	Params
+	Sub           string    `form:"subscription"`
+	Plan          string    `form:"plan"`
+	Price         string    `form:"price"`
+	Quantity      uint64    `form:"quantity"`
+	TaxRates      []string  `form:"tax_rates"`
+	NoProrate     bool      `form:"prorate,invert"`
+	ProrationDate Timestamp `form:"proration_date"`
+	// ClearUsage deletes the usage of a metered item along with it.
+	ClearUsage bool `form:"clear_usage"`
+}
+
+// Validate checks the subscription item parameters before they are sent.
+func (p *SubItemParams) Validate() error {
+	v := &validation{}
+	p.Params.validate(v)
+
+	if len(p.Plan) > 0 && len(p.Price) > 0 {
+		v.add("price", "cannot be used together with a plan")
+	}
+
+	// the subscription is only sent when creating an item
+	if len(p.Sub) > 0 && len(p.Plan) == 0 && len(p.Price) == 0 {
+		v.add("plan", "either plan or price must be set")
+	}
+
+	if p.NoProrate && p.ProrationDate > 0 {
+		v.add("proration_date", "cannot be used when not prorating")
+	}
+
+	return v.err()
+}
+
+// SubItemListParams is the set of parameters that can be used when listing the items of a subscription.
+// For more details see https://stripe.com/docs/api#list_subscription_items.
+type SubItemListParams struct {
+	ListParams
+	Sub string `form:"subscription"`
+}
+
+// Validate checks the subscription item list parameters before they are sent.
+func (p *SubItemListParams) Validate() error {
+	v := &validation{}
+	p.ListParams.validate(v)
+	v.required("subscription", p.Sub)
+	return v.err()
+}
+
+// SubItemsParams is an item of a subscription, set when creating or
+// updating the subscription. An existing item is referenced by its ID,
+// and is removed by setting Deleted.
+type SubItemsParams struct {
+	ID         string   `form:"id"`
+	Plan       string   `form:"plan"`
+	Price      string   `form:"price"`
+	Quantity   uint64   `form:"quantity"`
+	TaxRates   []string `form:"tax_rates"`
+	Deleted    bool     `form:"deleted"`
+	ClearUsage bool     `form:"clear_usage"`
+}
+
+// validate checks an item of the subscription params at index i.
+func (p *SubItemsParams) validate(v *validation, i int) {
+	param := fmt.Sprintf("items[%v]", i)
+
+	if len(p.Plan) > 0 && len(p.Price) > 0 {
+		v.add(param+"[price]", "cannot be used together with a plan")
+	}
+
+	if p.ClearUsage && !p.Deleted {
+		v.add(param+"[clear_usage]", "can only be used when deleting an item")
+	}
+
+	if len(p.ID) == 0 {
+		if p.Deleted {
+			v.add(param+"[id]", "is required to delete an item")
+		} else if len(p.Plan) == 0 && len(p.Price) == 0 {
+			v.add(param+"[plan]", "either plan or price must be set for a new item")
+		}
+	}
+}
+
+// SubItem is the resource representing a Stripe subscription item.
+// For more details see https://stripe.com/docs/api#subscription_items.
+type SubItem struct {
+	Extra
+	ID       string            `json:"id"`
+	Created  Timestamp         `json:"created"`
+	Meta     map[string]string `json:"metadata"`
+	Plan     *Plan             `json:"plan"`
+	Price    *Price            `json:"price"`
+	Quantity uint64            `json:"quantity"`
+	Sub      string            `json:"subscription"`
+	TaxRates []*TaxRate        `json:"tax_rates"`
+}
+
+// SubItemList is a list object for subscription items.
+type SubItemList struct {
+	ListMeta
+	Values []*SubItem `json:"data"`
+}
+
+// UnmarshalJSON handles deserialization of a SubItem.
+func (s *SubItem) UnmarshalJSON(data []byte) error {
+	type subItem SubItem
+	return unmarshalResource(data, (*subItem)(s))
+}
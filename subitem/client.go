@@ -0,0 +1,184 @@
+// Package subitem provides the /subscription_items APIs
+package subitem
+
+import (
+	"net/url"
+
+	stripe "github.com/channelmeter/stripe-go"
+	"github.com/channelmeter/stripe-go/form"
+)
+
+// Client is used to invoke /subscription_items APIs.
+type Client struct {
+	B   stripe.Backend
+	Key string
+	// NoValidate disables the client-side validation of params
+	// that is otherwise done before calling the backend.
+	NoValidate bool
+}
+
+// New POSTs a new subscription item.
+// For more details see https://stripe.com/docs/api#create_subscription_item.
+func New(params *stripe.SubItemParams) (*stripe.SubItem, error) {
+	return getC().New(params)
+}
+
+func (c Client) New(params *stripe.SubItemParams) (*stripe.SubItem, error) {
+	if err := stripe.ValidateParams(params, c.NoValidate); err != nil {
+		return nil, err
+	}
+
+	body := &url.Values{}
+	form.AppendTo(body, params)
+
+	item := &stripe.SubItem{}
+	err := c.B.Call("POST", "/subscription_items", c.Key, body, &params.Params, item)
+
+	return item, err
+}
+
+// Get returns the details of a subscription item.
+// For more details see https://stripe.com/docs/api#retrieve_subscription_item.
+func Get(id string, params *stripe.SubItemParams) (*stripe.SubItem, error) {
+	return getC().Get(id, params)
+}
+
+func (c Client) Get(id string, params *stripe.SubItemParams) (*stripe.SubItem, error) {
+	var body *url.Values
+	var commonParams *stripe.Params
+
+	if params != nil {
+		commonParams = &params.Params
+		body = &url.Values{}
+		params.AppendTo(body)
+	}
+
+	item := &stripe.SubItem{}
+	err := c.B.Call("GET", "/subscription_items/"+id, c.Key, body, commonParams, item)
+
+	return item, err
+}
+
+// Update updates a subscription item's properties.
+// For more details see https://stripe.com/docs/api#update_subscription_item.
+func Update(id string, params *stripe.SubItemParams) (*stripe.SubItem, error) {
+	return getC().Update(id, params)
+}
+
+func (c Client) Update(id string, params *stripe.SubItemParams) (*stripe.SubItem, error) {
+	if err := stripe.ValidateParams(params, c.NoValidate); err != nil {
+		return nil, err
+	}
+
+	var body *url.Values
+	var commonParams *stripe.Params
+
+	if params != nil {
+		commonParams = &params.Params
+		body = &url.Values{}
+		form.AppendTo(body, params)
+	}
+
+	item := &stripe.SubItem{}
+	err := c.B.Call("POST", "/subscription_items/"+id, c.Key, body, commonParams, item)
+
+	return item, err
+}
+
+// Del removes a subscription item, prorating the subscription
+// as set by the params.
+// For more details see https://stripe.com/docs/api#delete_subscription_item.
+func Del(id string, params *stripe.SubItemParams) error {
+	return getC().Del(id, params)
+}
+
+func (c Client) Del(id string, params *stripe.SubItemParams) error {
+	if err := stripe.ValidateParams(params, c.NoValidate); err != nil {
+		return err
+	}
+
+	var body *url.Values
+	var commonParams *stripe.Params
+
+	if params != nil {
+		commonParams = &params.Params
+		body = &url.Values{}
+		form.AppendTo(body, params)
+	}
+
+	return c.B.Call("DELETE", "/subscription_items/"+id, c.Key, body, commonParams, nil)
+}
+
+// List returns a list of the items of a subscription.
+// For more details see https://stripe.com/docs/api#list_subscription_items.
+func List(params *stripe.SubItemListParams) *Iter {
+	return getC().List(params)
+}
+
+func (c Client) List(params *stripe.SubItemListParams) *Iter {
+	if err := stripe.ValidateParams(params, c.NoValidate); err != nil {
+		return &Iter{stripe.GetIterErr(err)}
+	}
+
+	body := &url.Values{}
+	var lp *stripe.ListParams
+
+	form.AppendTo(body, params)
+	lp = &params.ListParams
+
+	return &Iter{stripe.GetIter(lp, body, c.query(params.Sub))}
+}
+
+// ListFrom returns all the items of a subscription, starting with the ones
+// embedded in it and fetching the remaining pages as needed.
+func ListFrom(s *stripe.Sub) *Iter {
+	return getC().ListFrom(s)
+}
+
+func (c Client) ListFrom(s *stripe.Sub) *Iter {
+	list := s.Items
+	if list == nil {
+		list = &stripe.SubItemList{ListMeta: stripe.ListMeta{More: true}}
+	}
+
+	values := make([]interface{}, len(list.Values))
+	for i, v := range list.Values {
+		values[i] = v
+	}
+
+	return &Iter{stripe.GetIterFrom(nil, nil, values, list.ListMeta, c.query(s.ID))}
+}
+
+// query returns the query fetching a page of items of a subscription.
+func (c Client) query(subID string) stripe.Query {
+	return func(b url.Values) ([]interface{}, stripe.ListMeta, error) {
+		b.Set("subscription", subID)
+
+		list := &stripe.SubItemList{}
+		err := c.B.Call("GET", "/subscription_items", c.Key, &b, nil, list)
+
+		ret := make([]interface{}, len(list.Values))
+		for i, v := range list.Values {
+			ret[i] = v
+		}
+
+		return ret, list.ListMeta, err
+	}
+}
+
+// Iter is an iterator for lists of SubItems.
+// The embedded Iter carries methods with it;
+// see its documentation for details.
+type Iter struct {
+	*stripe.Iter
+}
+
+// SubItem returns the most recent SubItem
+// visited by a call to Next.
+func (i *Iter) SubItem() *stripe.SubItem {
+	return i.Current().(*stripe.SubItem)
+}
+
+func getC() Client {
+	return Client{B: stripe.GetBackend(stripe.APIBackend), Key: stripe.Key}
+}
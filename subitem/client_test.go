@@ -0,0 +1,85 @@
+package subitem
+
+import (
+	"testing"
+
+	stripe "github.com/channelmeter/stripe-go"
+	"github.com/channelmeter/stripe-go/currency"
+	"github.com/channelmeter/stripe-go/customer"
+	"github.com/channelmeter/stripe-go/plan"
+	"github.com/channelmeter/stripe-go/sub"
+	. "github.com/channelmeter/stripe-go/utils"
+)
+
+func init() {
+	stripe.Key = GetTestKey()
+}
+
+func TestSubItems(t *testing.T) {
+	cust, _ := customer.New(&stripe.CustomerParams{
+		Source: &stripe.SourceParams{Token: "tok_visa"},
+	})
+
+	for _, id := range []string{"test_base", "test_addon"} {
+		plan.New(&stripe.PlanParams{
+			ID:       id,
+			Name:     "Test Plan",
+			Amount:   99,
+			Currency: currency.USD,
+			Interval: plan.Month,
+		})
+	}
+
+	s, err := sub.New(&stripe.SubParams{
+		Customer: cust.ID,
+		Items:    []*stripe.SubItemsParams{{Plan: "test_base"}},
+	})
+
+	if err != nil {
+		t.Error(err)
+	}
+
+	item, err := New(&stripe.SubItemParams{Sub: s.ID, Plan: "test_addon", Quantity: 2})
+
+	if err != nil {
+		t.Error(err)
+	}
+
+	if item.Plan.ID != "test_addon" || item.Quantity != 2 {
+		t.Errorf("Item %+v does not match the expected plan and quantity\n", item)
+	}
+
+	item, err = Update(item.ID, &stripe.SubItemParams{Quantity: 5, NoProrate: true})
+
+	if err != nil {
+		t.Error(err)
+	}
+
+	if item.Quantity != 5 {
+		t.Errorf("Quantity %v does not match expected quantity 5\n", item.Quantity)
+	}
+
+	i := List(&stripe.SubItemListParams{Sub: s.ID})
+	count := 0
+	for i.Next() {
+		if i.SubItem().Sub != s.ID {
+			t.Errorf("Item subscription %q does not match expected %q\n", i.SubItem().Sub, s.ID)
+		}
+		count++
+	}
+	if err := i.Err(); err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Errorf("Expected two items but got %v\n", count)
+	}
+
+	if err := Del(item.ID, nil); err != nil {
+		t.Error(err)
+	}
+
+	customer.Del(cust.ID)
+	plan.Del("test_base")
+	plan.Del("test_addon")
+}
@@ -0,0 +1,80 @@
+package stripe
+
+// TaxIDType is the type of a customer tax ID, such as "eu_vat", "gb_vat",
+// "au_abn" or "in_gst". The list of types grows as Stripe supports more
+// countries, so it is not checked before sending.
+type TaxIDType string
+
+// TaxIDVerificationStatus is the list of allowed values for the verification status of a tax ID.
+// Allowed values are "pending", "verified", "unverified", "unavailable".
+type TaxIDVerificationStatus string
+
+// TaxIDParams is the set of parameters that can be used when adding, retrieving or deleting a customer tax ID.
+// The type and value are only sent when adding one.
+// For more details see https://stripe.com/docs/api/customer_tax_ids/create.
+type TaxIDParams struct {
+	Params
+	Customer string    `form:"-"`
+	Type     TaxIDType `form:"type"`
+	Value    string    `form:"value"`
+}
+
+// Validate checks the tax ID parameters before they are sent.
+func (p *TaxIDParams) Validate() error {
+	v := &validation{}
+	p.Params.validate(v)
+	v.required("customer", p.Customer)
+	v.required("type", string(p.Type))
+	v.required("value", p.Value)
+	return v.err()
+}
+
+// TaxIDListParams is the set of parameters that can be used when listing the tax IDs of a customer.
+// For more details see https://stripe.com/docs/api/customer_tax_ids/list.
+type TaxIDListParams struct {
+	ListParams
+	Customer string `form:"-"`
+}
+
+// Validate checks the tax ID list parameters before they are sent.
+func (p *TaxIDListParams) Validate() error {
+	v := &validation{}
+	p.ListParams.validate(v)
+	v.required("customer", p.Customer)
+	return v.err()
+}
+
+// TaxID is the resource representing a customer tax ID, such as a VAT or GST number.
+// For more details see https://stripe.com/docs/api/customer_tax_ids.
+type TaxID struct {
+	Extra
+	ID           string             `json:"id"`
+	Live         bool               `json:"livemode"`
+	Country      string             `json:"country"`
+	Created      Timestamp          `json:"created"`
+	Customer     *Customer          `json:"customer"`
+	Type         TaxIDType          `json:"type"`
+	Value        string             `json:"value"`
+	Verification *TaxIDVerification `json:"verification"`
+	Deleted      bool               `json:"deleted"`
+}
+
+// TaxIDVerification is the result of the verification of a tax ID
+// with the tax authority.
+type TaxIDVerification struct {
+	Status  TaxIDVerificationStatus `json:"status"`
+	Address string                  `json:"verified_address"`
+	Name    string                  `json:"verified_name"`
+}
+
+// TaxIDList is a list object for tax IDs.
+type TaxIDList struct {
+	ListMeta
+	Values []*TaxID `json:"data"`
+}
+
+// UnmarshalJSON handles deserialization of a TaxID.
+func (t *TaxID) UnmarshalJSON(data []byte) error {
+	type taxID TaxID
+	return unmarshalResource(data, (*taxID)(t))
+}
@@ -0,0 +1,130 @@
+package stripe
+
+// TaxRateParams is the set of parameters that can be used when creating a tax rate.
+// The percentage is always sent, so a 0% rate is created from its zero value, and
+// either Inclusive or Exclusive is required.
+// For more details see https://stripe.com/docs/api/tax_rates/create.
+type TaxRateParams struct {
+	Params
+	Active       bool    `form:"active"`
+	Country      string  `form:"country"`
+	Desc         string  `form:"description"`
+	DisplayName  string  `form:"display_name"`
+	Inclusive    bool    `form:"inclusive"`
+	Exclusive    bool    `form:"inclusive,invert"`
+	Jurisdiction string  `form:"jurisdiction"`
+	Percentage   float64 `form:"percentage,zero"`
+	State        string  `form:"state"`
+}
+
+// Validate checks the tax rate parameters before they are sent.
+func (p *TaxRateParams) Validate() error {
+	v := &validation{}
+	p.Params.validate(v)
+	v.required("display_name", p.DisplayName)
+	v.percent("percentage", p.Percentage)
+	v.maxLen("display_name", p.DisplayName, 50)
+	v.maxLen("jurisdiction", p.Jurisdiction, 50)
+
+	switch {
+	case p.Inclusive && p.Exclusive:
+		v.add("inclusive", "a tax rate cannot be both inclusive and exclusive")
+	case !p.Inclusive && !p.Exclusive:
+		v.add("inclusive", "is required")
+	}
+
+	return v.err()
+}
+
+// TaxRateUpdateParams is the set of parameters that can be used when updating a tax rate.
+// The percentage and whether the rate is inclusive cannot be changed. Active is only
+// sent when true, so archiving a tax rate is done with SetZero("active").
+// For more details see https://stripe.com/docs/api/tax_rates/update.
+type TaxRateUpdateParams struct {
+	Params
+	Active       bool   `form:"active"`
+	Country      string `form:"country"`
+	Desc         string `form:"description"`
+	DisplayName  string `form:"display_name"`
+	Jurisdiction string `form:"jurisdiction"`
+	State        string `form:"state"`
+}
+
+// Validate checks the tax rate update parameters before they are sent.
+func (p *TaxRateUpdateParams) Validate() error {
+	v := &validation{}
+	p.Params.validate(v)
+	v.maxLen("display_name", p.DisplayName, 50)
+	v.maxLen("jurisdiction", p.Jurisdiction, 50)
+	return v.err()
+}
+
+// TaxRateListParams is the set of parameters that can be used when listing tax rates.
+// For more details see https://stripe.com/docs/api/tax_rates/list.
+type TaxRateListParams struct {
+	ListParams
+	Active       bool         `form:"active"`
+	Inactive     bool         `form:"active,invert"`
+	Created      Timestamp    `form:"created"`
+	CreatedRange *RangeParams `form:"created"`
+	Inclusive    bool         `form:"inclusive"`
+	Exclusive    bool         `form:"inclusive,invert"`
+}
+
+// Validate checks the tax rate list parameters before they are sent.
+func (p *TaxRateListParams) Validate() error {
+	v := &validation{}
+	p.ListParams.validate(v)
+	v.timeRange("created", p.Created, p.CreatedRange)
+
+	if p.Active && p.Inactive {
+		v.add("active", "cannot list both only active and only inactive tax rates")
+	}
+
+	if p.Inclusive && p.Exclusive {
+		v.add("inclusive", "cannot list both only inclusive and only exclusive tax rates")
+	}
+
+	return v.err()
+}
+
+// TaxRate is the resource representing a Stripe tax rate.
+// For more details see https://stripe.com/docs/api/tax_rates.
+type TaxRate struct {
+	Extra
+	ID           string            `json:"id"`
+	Live         bool              `json:"livemode"`
+	Active       bool              `json:"active"`
+	Country      string            `json:"country"`
+	Created      Timestamp         `json:"created"`
+	Desc         string            `json:"description"`
+	DisplayName  string            `json:"display_name"`
+	Inclusive    bool              `json:"inclusive"`
+	Jurisdiction string            `json:"jurisdiction"`
+	Meta         map[string]string `json:"metadata"`
+	Percentage   float64           `json:"percentage"`
+	State        string            `json:"state"`
+	Expanded     bool              `json:"-"`
+}
+
+// TaxRateList is a list object for tax rates.
+type TaxRateList struct {
+	ListMeta
+	Values []*TaxRate `json:"data"`
+}
+
+// TaxAmount is the amount of tax charged at a tax rate, as broken out
+// on invoices and their line items.
+type TaxAmount struct {
+	Amount    int64    `json:"amount"`
+	Inclusive bool     `json:"inclusive"`
+	TaxRate   *TaxRate `json:"tax_rate"`
+}
+
+// UnmarshalJSON handles deserialization of a TaxRate.
+// This custom unmarshaling is needed because the resulting
+// property may be an id or the full struct if it was expanded.
+func (t *TaxRate) UnmarshalJSON(data []byte) error {
+	type taxRate TaxRate
+	return unmarshalExpandable(data, &t.ID, &t.Expanded, (*taxRate)(t))
+}
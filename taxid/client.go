@@ -0,0 +1,174 @@
+// Package taxid provides the /customers/tax_ids APIs
+package taxid
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+
+	stripe "github.com/channelmeter/stripe-go"
+	"github.com/channelmeter/stripe-go/form"
+)
+
+const (
+	EUVAT   stripe.TaxIDType = "eu_vat"
+	GBVAT   stripe.TaxIDType = "gb_vat"
+	CHVAT   stripe.TaxIDType = "ch_vat"
+	NOVAT   stripe.TaxIDType = "no_vat"
+	ZAVAT   stripe.TaxIDType = "za_vat"
+	AUABN   stripe.TaxIDType = "au_abn"
+	INGST   stripe.TaxIDType = "in_gst"
+	NZGST   stripe.TaxIDType = "nz_gst"
+	SGGST   stripe.TaxIDType = "sg_gst"
+	CABN    stripe.TaxIDType = "ca_bn"
+	USEIN   stripe.TaxIDType = "us_ein"
+	Unknown stripe.TaxIDType = "unknown"
+
+	Pending     stripe.TaxIDVerificationStatus = "pending"
+	Verified    stripe.TaxIDVerificationStatus = "verified"
+	Unverified  stripe.TaxIDVerificationStatus = "unverified"
+	Unavailable stripe.TaxIDVerificationStatus = "unavailable"
+)
+
+// Client is used to invoke /customers/tax_ids APIs.
+type Client struct {
+	B   stripe.Backend
+	Key string
+	// NoValidate disables the client-side validation of params
+	// that is otherwise done before calling the backend.
+	NoValidate bool
+}
+
+// New POSTs a new tax ID for a customer.
+// For more details see https://stripe.com/docs/api/customer_tax_ids/create.
+func New(params *stripe.TaxIDParams) (*stripe.TaxID, error) {
+	return getC().New(params)
+}
+
+func (c Client) New(params *stripe.TaxIDParams) (*stripe.TaxID, error) {
+	if err := stripe.ValidateParams(params, c.NoValidate); err != nil {
+		return nil, err
+	}
+
+	body := &url.Values{}
+	form.AppendTo(body, params)
+
+	taxID := &stripe.TaxID{}
+	var err error
+
+	if len(params.Customer) > 0 {
+		err = c.B.Call("POST", fmt.Sprintf("/customers/%v/tax_ids", params.Customer), c.Key, body, stripe.CurrentVersion(&params.Params), taxID)
+	} else {
+		err = errors.New("Invalid tax ID params: customer needs to be set")
+	}
+
+	return taxID, err
+}
+
+// Get returns the details of a customer's tax ID.
+// For more details see https://stripe.com/docs/api/customer_tax_ids/retrieve.
+func Get(id string, params *stripe.TaxIDParams) (*stripe.TaxID, error) {
+	return getC().Get(id, params)
+}
+
+func (c Client) Get(id string, params *stripe.TaxIDParams) (*stripe.TaxID, error) {
+	body := &url.Values{}
+	params.AppendTo(body)
+
+	taxID := &stripe.TaxID{}
+	var err error
+
+	if len(params.Customer) > 0 {
+		err = c.B.Call("GET", fmt.Sprintf("/customers/%v/tax_ids/%v", params.Customer, id), c.Key, body, stripe.CurrentVersion(&params.Params), taxID)
+	} else {
+		err = errors.New("Invalid tax ID params: customer needs to be set")
+	}
+
+	return taxID, err
+}
+
+// Del removes a customer's tax ID.
+// For more details see https://stripe.com/docs/api/customer_tax_ids/delete.
+func Del(id string, params *stripe.TaxIDParams) error {
+	return getC().Del(id, params)
+}
+
+func (c Client) Del(id string, params *stripe.TaxIDParams) error {
+	if len(params.Customer) > 0 {
+		return c.B.Call("DELETE", fmt.Sprintf("/customers/%v/tax_ids/%v", params.Customer, id), c.Key, nil, stripe.CurrentVersion(&params.Params), nil)
+	}
+
+	return errors.New("Invalid tax ID params: customer needs to be set")
+}
+
+// List returns a list of the tax IDs of a customer.
+// For more details see https://stripe.com/docs/api/customer_tax_ids/list.
+func List(params *stripe.TaxIDListParams) *Iter {
+	return getC().List(params)
+}
+
+func (c Client) List(params *stripe.TaxIDListParams) *Iter {
+	if err := stripe.ValidateParams(params, c.NoValidate); err != nil {
+		return &Iter{stripe.GetIterErr(err)}
+	}
+
+	body := &url.Values{}
+	var lp *stripe.ListParams
+
+	form.AppendTo(body, params)
+	lp = &params.ListParams
+
+	return &Iter{stripe.GetIter(lp, body, c.query(params.Customer))}
+}
+
+// ListFrom returns all the tax IDs of a customer, starting with the ones
+// embedded in it and fetching the remaining pages as needed.
+func ListFrom(cust *stripe.Customer) *Iter {
+	return getC().ListFrom(cust)
+}
+
+func (c Client) ListFrom(cust *stripe.Customer) *Iter {
+	list := cust.TaxIDs
+	if list == nil {
+		list = &stripe.TaxIDList{ListMeta: stripe.ListMeta{More: true}}
+	}
+
+	values := make([]interface{}, len(list.Values))
+	for i, v := range list.Values {
+		values[i] = v
+	}
+
+	return &Iter{stripe.GetIterFrom(nil, nil, values, list.ListMeta, c.query(cust.ID))}
+}
+
+// query returns the query fetching a page of tax IDs of a customer.
+func (c Client) query(customerID string) stripe.Query {
+	return func(b url.Values) ([]interface{}, stripe.ListMeta, error) {
+		list := &stripe.TaxIDList{}
+		err := c.B.Call("GET", fmt.Sprintf("/customers/%v/tax_ids", customerID), c.Key, &b, stripe.CurrentVersion(nil), list)
+
+		ret := make([]interface{}, len(list.Values))
+		for i, v := range list.Values {
+			ret[i] = v
+		}
+
+		return ret, list.ListMeta, err
+	}
+}
+
+// Iter is an iterator for lists of TaxIDs.
+// The embedded Iter carries methods with it;
+// see its documentation for details.
+type Iter struct {
+	*stripe.Iter
+}
+
+// TaxID returns the most recent TaxID
+// visited by a call to Next.
+func (i *Iter) TaxID() *stripe.TaxID {
+	return i.Current().(*stripe.TaxID)
+}
+
+func getC() Client {
+	return Client{B: stripe.GetBackend(stripe.APIBackend), Key: stripe.Key}
+}
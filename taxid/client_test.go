@@ -0,0 +1,67 @@
+package taxid
+
+import (
+	"testing"
+
+	stripe "github.com/channelmeter/stripe-go"
+	"github.com/channelmeter/stripe-go/customer"
+	. "github.com/channelmeter/stripe-go/utils"
+)
+
+func init() {
+	stripe.Key = GetTestKey()
+}
+
+func TestTaxIDScenarios(t *testing.T) {
+	cust, _ := customer.New(&stripe.CustomerParams{Desc: "Tax ID customer"})
+
+	params := &stripe.TaxIDParams{
+		Customer: cust.ID,
+		Type:     EUVAT,
+		Value:    "DE123456789",
+	}
+
+	target, err := New(params)
+
+	if err != nil {
+		t.Error(err)
+	}
+
+	if target.Type != params.Type {
+		t.Errorf("Type %q does not match expected type %q\n", target.Type, params.Type)
+	}
+
+	if target.Value != params.Value {
+		t.Errorf("Value %q does not match expected value %q\n", target.Value, params.Value)
+	}
+
+	if target.Country != "DE" {
+		t.Errorf("Country %q does not match expected country DE\n", target.Country)
+	}
+
+	target, err = Get(target.ID, &stripe.TaxIDParams{Customer: cust.ID})
+
+	if err != nil {
+		t.Error(err)
+	}
+
+	if target.Verification == nil {
+		t.Errorf("Verification is not set\n")
+	}
+
+	i := List(&stripe.TaxIDListParams{Customer: cust.ID})
+	for i.Next() {
+		if i.TaxID().ID != target.ID {
+			t.Errorf("Tax ID %q does not match expected ID %q\n", i.TaxID().ID, target.ID)
+		}
+	}
+	if err := i.Err(); err != nil {
+		t.Error(err)
+	}
+
+	if err = Del(target.ID, &stripe.TaxIDParams{Customer: cust.ID}); err != nil {
+		t.Error(err)
+	}
+
+	customer.Del(cust.ID)
+}
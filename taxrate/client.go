@@ -0,0 +1,137 @@
+// Package taxrate provides the /tax_rates APIs
+package taxrate
+
+import (
+	"net/url"
+
+	stripe "github.com/channelmeter/stripe-go"
+	"github.com/channelmeter/stripe-go/form"
+)
+
+// Client is used to invoke /tax_rates APIs.
+type Client struct {
+	B   stripe.Backend
+	Key string
+	// NoValidate disables the client-side validation of params
+	// that is otherwise done before calling the backend.
+	NoValidate bool
+}
+
+// New POSTs a new tax rate.
+// For more details see https://stripe.com/docs/api/tax_rates/create.
+func New(params *stripe.TaxRateParams) (*stripe.TaxRate, error) {
+	return getC().New(params)
+}
+
+func (c Client) New(params *stripe.TaxRateParams) (*stripe.TaxRate, error) {
+	if err := stripe.ValidateParams(params, c.NoValidate); err != nil {
+		return nil, err
+	}
+
+	body := &url.Values{}
+	form.AppendTo(body, params)
+
+	rate := &stripe.TaxRate{}
+	err := c.B.Call("POST", "/tax_rates", c.Key, body, stripe.CurrentVersion(&params.Params), rate)
+
+	return rate, err
+}
+
+// Get returns the details of a tax rate.
+// For more details see https://stripe.com/docs/api/tax_rates/retrieve.
+func Get(id string, params *stripe.TaxRateParams) (*stripe.TaxRate, error) {
+	return getC().Get(id, params)
+}
+
+func (c Client) Get(id string, params *stripe.TaxRateParams) (*stripe.TaxRate, error) {
+	var body *url.Values
+	var commonParams *stripe.Params
+
+	if params != nil {
+		commonParams = &params.Params
+		body = &url.Values{}
+		params.AppendTo(body)
+	}
+
+	rate := &stripe.TaxRate{}
+	err := c.B.Call("GET", "/tax_rates/"+id, c.Key, body, stripe.CurrentVersion(commonParams), rate)
+
+	return rate, err
+}
+
+// Update updates a tax rate's properties.
+// For more details see https://stripe.com/docs/api/tax_rates/update.
+func Update(id string, params *stripe.TaxRateUpdateParams) (*stripe.TaxRate, error) {
+	return getC().Update(id, params)
+}
+
+func (c Client) Update(id string, params *stripe.TaxRateUpdateParams) (*stripe.TaxRate, error) {
+	if err := stripe.ValidateParams(params, c.NoValidate); err != nil {
+		return nil, err
+	}
+
+	var body *url.Values
+	var commonParams *stripe.Params
+
+	if params != nil {
+		commonParams = &params.Params
+
+		body = &url.Values{}
+		form.AppendTo(body, params)
+	}
+
+	rate := &stripe.TaxRate{}
+	err := c.B.Call("POST", "/tax_rates/"+id, c.Key, body, stripe.CurrentVersion(commonParams), rate)
+
+	return rate, err
+}
+
+// List returns a list of tax rates.
+// For more details see https://stripe.com/docs/api/tax_rates/list.
+func List(params *stripe.TaxRateListParams) *Iter {
+	return getC().List(params)
+}
+
+func (c Client) List(params *stripe.TaxRateListParams) *Iter {
+	if err := stripe.ValidateParams(params, c.NoValidate); err != nil {
+		return &Iter{stripe.GetIterErr(err)}
+	}
+
+	var body *url.Values
+	var lp *stripe.ListParams
+
+	if params != nil {
+		body = &url.Values{}
+		form.AppendTo(body, params)
+		lp = &params.ListParams
+	}
+
+	return &Iter{stripe.GetIter(lp, body, func(b url.Values) ([]interface{}, stripe.ListMeta, error) {
+		list := &stripe.TaxRateList{}
+		err := c.B.Call("GET", "/tax_rates", c.Key, &b, stripe.CurrentVersion(nil), list)
+
+		ret := make([]interface{}, len(list.Values))
+		for i, v := range list.Values {
+			ret[i] = v
+		}
+
+		return ret, list.ListMeta, err
+	})}
+}
+
+// Iter is an iterator for lists of TaxRates.
+// The embedded Iter carries methods with it;
+// see its documentation for details.
+type Iter struct {
+	*stripe.Iter
+}
+
+// TaxRate returns the most recent TaxRate
+// visited by a call to Next.
+func (i *Iter) TaxRate() *stripe.TaxRate {
+	return i.Current().(*stripe.TaxRate)
+}
+
+func getC() Client {
+	return Client{B: stripe.GetBackend(stripe.APIBackend), Key: stripe.Key}
+}
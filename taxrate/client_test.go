@@ -0,0 +1,93 @@
+package taxrate
+
+import (
+	"testing"
+
+	stripe "github.com/channelmeter/stripe-go"
+	. "github.com/channelmeter/stripe-go/utils"
+)
+
+func init() {
+	stripe.Key = GetTestKey()
+}
+
+func TestTaxRateNew(t *testing.T) {
+	params := &stripe.TaxRateParams{
+		DisplayName:  "VAT",
+		Desc:         "VAT Germany",
+		Jurisdiction: "DE",
+		Percentage:   19,
+		Exclusive:    true,
+	}
+
+	target, err := New(params)
+
+	if err != nil {
+		t.Error(err)
+	}
+
+	if target.Percentage != params.Percentage {
+		t.Errorf("Percentage %v does not match expected percentage %v\n", target.Percentage, params.Percentage)
+	}
+
+	if target.Inclusive {
+		t.Errorf("Tax rate is inclusive but should not be\n")
+	}
+
+	if target.Jurisdiction != params.Jurisdiction {
+		t.Errorf("Jurisdiction %q does not match expected jurisdiction %q\n", target.Jurisdiction, params.Jurisdiction)
+	}
+
+	if !target.Active {
+		t.Errorf("Tax rate is not active\n")
+	}
+}
+
+func TestTaxRateUpdate(t *testing.T) {
+	target, err := New(&stripe.TaxRateParams{
+		DisplayName: "GST",
+		Percentage:  10,
+		Inclusive:   true,
+	})
+
+	if err != nil {
+		t.Error(err)
+	}
+
+	params := &stripe.TaxRateUpdateParams{DisplayName: "GST (AU)"}
+	params.SetZero("active")
+
+	target, err = Update(target.ID, params)
+
+	if err != nil {
+		t.Error(err)
+	}
+
+	if target.DisplayName != params.DisplayName {
+		t.Errorf("Display name %q does not match expected display name %q\n", target.DisplayName, params.DisplayName)
+	}
+
+	if target.Active {
+		t.Errorf("Tax rate is still active\n")
+	}
+
+	if !target.Inclusive {
+		t.Errorf("Tax rate is not inclusive\n")
+	}
+}
+
+func TestTaxRateList(t *testing.T) {
+	params := &stripe.TaxRateListParams{Active: true}
+	params.Filters.AddFilter("limit", "", "5")
+	params.Single = true
+
+	i := List(params)
+	for i.Next() {
+		if i.TaxRate() == nil {
+			t.Error("No nil values expected")
+		}
+	}
+	if err := i.Err(); err != nil {
+		t.Error(err)
+	}
+}
@@ -0,0 +1,183 @@
+// Package testmode provides the card numbers and bank accounts that trigger
+// specific outcomes when used with a test mode API key.
+// For more details see https://stripe.com/docs/testing.
+package testmode
+
+import (
+	"strconv"
+	"time"
+
+	stripe "github.com/channelmeter/stripe-go"
+	"github.com/channelmeter/stripe-go/card"
+	"github.com/channelmeter/stripe-go/currency"
+)
+
+// Card is a test card number along with the outcome Stripe produces for it.
+// Code is the error code returned when the card is charged, or empty if the
+// charge succeeds. Month, Year and CVC override the defaults used by Params
+// for the cards that exercise parameter errors.
+type Card struct {
+	Number           string
+	Brand            stripe.CardBrand
+	Funding          stripe.CardFunding
+	Code             stripe.ErrorCode
+	Month, Year, CVC string
+	Desc             string
+}
+
+// BankAccount is a test bank account along with the outcome Stripe produces
+// when it receives a transfer. Code is empty when the transfer is paid.
+type BankAccount struct {
+	Country, Routing, Account string
+	Currency                  stripe.Currency
+	Code                      string
+	Desc                      string
+}
+
+// Cards that are charged successfully.
+var (
+	Visa = Card{Number: "4242424242424242", Brand: card.Visa, Funding: card.Credit,
+		Desc: "Visa, charge succeeds."}
+	VisaAlt = Card{Number: "4012888888881881", Brand: card.Visa, Funding: card.Credit,
+		Desc: "Visa, charge succeeds."}
+	VisaDebit = Card{Number: "4000056655665556", Brand: card.Visa, Funding: card.Debit,
+		Desc: "Visa debit card, charge succeeds and the card can receive transfers."}
+	MasterCard = Card{Number: "5555555555554444", Brand: card.MasterCard, Funding: card.Credit,
+		Desc: "MasterCard, charge succeeds."}
+	MasterCardDebit = Card{Number: "5200828282828210", Brand: card.MasterCard, Funding: card.Debit,
+		Desc: "MasterCard debit card, charge succeeds and the card can receive transfers."}
+	MasterCardPrepaid = Card{Number: "5105105105105100", Brand: card.MasterCard, Funding: card.Prepaid,
+		Desc: "MasterCard prepaid card, charge succeeds."}
+	Amex = Card{Number: "378282246310005", Brand: card.Amex, Funding: card.Credit,
+		Desc: "American Express, charge succeeds."}
+	AmexAlt = Card{Number: "371449635398431", Brand: card.Amex, Funding: card.Credit,
+		Desc: "American Express, charge succeeds."}
+	Discover = Card{Number: "6011111111111117", Brand: card.Discover, Funding: card.Credit,
+		Desc: "Discover, charge succeeds."}
+	DiscoverAlt = Card{Number: "6011000990139424", Brand: card.Discover, Funding: card.Credit,
+		Desc: "Discover, charge succeeds."}
+	DinersClub = Card{Number: "30569309025904", Brand: card.DinersClub, Funding: card.Credit,
+		Desc: "Diners Club, charge succeeds."}
+	DinersClubAlt = Card{Number: "38520000023237", Brand: card.DinersClub, Funding: card.Credit,
+		Desc: "Diners Club, charge succeeds."}
+	JCB = Card{Number: "3530111333300000", Brand: card.JCB, Funding: card.Credit,
+		Desc: "JCB, charge succeeds."}
+	JCBAlt = Card{Number: "3566002020360505", Brand: card.JCB, Funding: card.Credit,
+		Desc: "JCB, charge succeeds."}
+)
+
+// Cards that succeed but exercise specific checks or balance behaviour.
+var (
+	BypassPending = Card{Number: "4000000000000077", Brand: card.Visa, Funding: card.Credit,
+		Desc: "Charge succeeds and the funds are added directly to the available balance, bypassing the pending balance."}
+	AddressFail = Card{Number: "4000000000000010", Brand: card.Visa, Funding: card.Credit,
+		Desc: "Charge succeeds but address_line1_check and address_zip_check both fail."}
+	AddressLine1Fail = Card{Number: "4000000000000028", Brand: card.Visa, Funding: card.Credit,
+		Desc: "Charge succeeds but address_line1_check fails."}
+	ZipFail = Card{Number: "4000000000000036", Brand: card.Visa, Funding: card.Credit,
+		Desc: "Charge succeeds but address_zip_check fails."}
+	CVCCheckFail = Card{Number: "4000000000000101", Brand: card.Visa, Funding: card.Credit,
+		Desc: "Charge succeeds but cvc_check fails when a CVC is provided."}
+	Dispute = Card{Number: "4000000000000259", Brand: card.Visa, Funding: card.Credit,
+		Desc: "Charge succeeds and is then disputed as fraudulent."}
+)
+
+// Cards that are declined or fail with a specific error code.
+var (
+	AttachThenDecline = Card{Number: "4000000000000341", Brand: card.Visa, Funding: card.Credit, Code: stripe.CardDeclined,
+		Desc: "Attaching the card to a customer succeeds but charging the customer is declined."}
+	Declined = Card{Number: "4000000000000002", Brand: card.Visa, Funding: card.Credit, Code: stripe.CardDeclined,
+		Desc: "Charge is declined with a card_declined code."}
+	DeclinedFraudulent = Card{Number: "4100000000000019", Brand: card.Visa, Funding: card.Credit, Code: stripe.CardDeclined,
+		Desc: "Charge is declined with a card_declined code and marked as fraudulent by Stripe."}
+	IncorrectCVC = Card{Number: "4000000000000127", Brand: card.Visa, Funding: card.Credit, Code: stripe.IncorrectCvc,
+		Desc: "Charge is declined with an incorrect_cvc code."}
+	ExpiredCard = Card{Number: "4000000000000069", Brand: card.Visa, Funding: card.Credit, Code: stripe.ExpiredCard,
+		Desc: "Charge is declined with an expired_card code."}
+	ProcessingError = Card{Number: "4000000000000119", Brand: card.Visa, Funding: card.Credit, Code: stripe.ProcessingErr,
+		Desc: "Charge is declined with a processing_error code."}
+	IncorrectNumber = Card{Number: "4242424242424241", Brand: card.Visa, Funding: card.Credit, Code: stripe.IncorrectNum,
+		Desc: "Charge is declined with an incorrect_number code as the number fails the Luhn check."}
+	InvalidExpiryMonth = Card{Number: "4242424242424242", Brand: card.Visa, Funding: card.Credit, Code: stripe.InvalidExpM,
+		Month: "13", Desc: "Charge is declined with an invalid_expiry_month code."}
+	InvalidExpiryYear = Card{Number: "4242424242424242", Brand: card.Visa, Funding: card.Credit, Code: stripe.InvalidExpY,
+		Year: "1970", Desc: "Charge is declined with an invalid_expiry_year code."}
+	InvalidCVC = Card{Number: "4242424242424242", Brand: card.Visa, Funding: card.Credit, Code: stripe.InvalidCvc,
+		CVC: "99", Desc: "Charge is declined with an invalid_cvc code."}
+)
+
+// Bank accounts for testing transfers and recipients.
+var (
+	BankAccountUS = BankAccount{Country: "US", Routing: "110000000", Account: "000123456789", Currency: currency.USD,
+		Desc: "Transfer to the account is paid."}
+	BankAccountUSFail = BankAccount{Country: "US", Routing: "110000000", Account: "000111111116", Currency: currency.USD,
+		Code: "no_account", Desc: "Transfer to the account fails with a no_account failure code."}
+	BankAccountUSClosed = BankAccount{Country: "US", Routing: "110000000", Account: "000111111113", Currency: currency.USD,
+		Code: "account_closed", Desc: "Transfer to the account fails with an account_closed failure code."}
+)
+
+// Successful lists the cards that are charged successfully for each brand.
+var Successful = []Card{
+	Visa, VisaAlt, VisaDebit,
+	MasterCard, MasterCardDebit, MasterCardPrepaid,
+	Amex, AmexAlt,
+	Discover, DiscoverAlt,
+	DinersClub, DinersClubAlt,
+	JCB, JCBAlt,
+}
+
+// Declines lists the cards that fail with an error code when charged.
+var Declines = []Card{
+	AttachThenDecline, Declined, DeclinedFraudulent, IncorrectCVC, ExpiredCard,
+	ProcessingError, IncorrectNumber, InvalidExpiryMonth, InvalidExpiryYear, InvalidCVC,
+}
+
+// Params returns new card parameters for the test card. Unless overridden
+// by the card, the expiry is December three years from now and the CVC
+// has the length expected by the brand.
+func (c Card) Params() *stripe.CardParams {
+	params := &stripe.CardParams{
+		Number: c.Number,
+		Month:  "12",
+		Year:   strconv.Itoa(time.Now().Year() + 3),
+		CVC:    "123",
+	}
+
+	if c.Brand == card.Amex {
+		params.CVC = "1234"
+	}
+
+	if len(c.Month) > 0 {
+		params.Month = c.Month
+	}
+
+	if len(c.Year) > 0 {
+		params.Year = c.Year
+	}
+
+	if len(c.CVC) > 0 {
+		params.CVC = c.CVC
+	}
+
+	return params
+}
+
+// Succeeds returns whether charging the card is expected to succeed.
+func (c Card) Succeeds() bool {
+	return len(c.Code) == 0
+}
+
+// Params returns new bank account parameters for the test account.
+func (b BankAccount) Params() *stripe.BankAccountParams {
+	return &stripe.BankAccountParams{
+		Country:  b.Country,
+		Routing:  b.Routing,
+		Account:  b.Account,
+		Currency: string(b.Currency),
+	}
+}
+
+// Succeeds returns whether a transfer to the account is expected to be paid.
+func (b BankAccount) Succeeds() bool {
+	return len(b.Code) == 0
+}
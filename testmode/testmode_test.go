@@ -0,0 +1,35 @@
+package testmode
+
+import (
+	"testing"
+
+	"github.com/channelmeter/stripe-go/card"
+)
+
+func TestCardsMatchBrand(t *testing.T) {
+	for _, c := range append(Successful, Declines...) {
+		if got := card.Brand(c.Number); got != c.Brand {
+			t.Errorf("%v: brand = %v want %v", c.Number, got, c.Brand)
+		}
+	}
+}
+
+func TestSuccessfulCardsValidate(t *testing.T) {
+	for _, c := range Successful {
+		if !c.Succeeds() {
+			t.Errorf("%v: expected to succeed", c.Number)
+		}
+
+		if err := card.Validate(c.Params()); err != nil {
+			t.Errorf("%v: %v", c.Number, err)
+		}
+	}
+}
+
+func TestParamErrorCardsFailValidation(t *testing.T) {
+	for _, c := range []Card{IncorrectNumber, InvalidExpiryMonth, InvalidExpiryYear, InvalidCVC} {
+		if err := card.Validate(c.Params()); err == nil {
+			t.Errorf("%v: expected a validation error for %v", c.Number, c.Code)
+		}
+	}
+}
@@ -0,0 +1,32 @@
+package stripe
+
+import "time"
+
+// Timestamp is a time as sent to and received from the API, in seconds
+// since the Unix epoch. The zero Timestamp stands for an unset time.
+type Timestamp int64
+
+// NewTimestamp returns the Timestamp of t, which is the zero Timestamp for
+// the zero time.
+func NewTimestamp(t time.Time) Timestamp {
+	if t.IsZero() {
+		return 0
+	}
+
+	return Timestamp(t.Unix())
+}
+
+// Time returns the time of the Timestamp, which is the zero time when the
+// Timestamp is unset.
+func (t Timestamp) Time() time.Time {
+	if t == 0 {
+		return time.Time{}
+	}
+
+	return time.Unix(int64(t), 0)
+}
+
+// IsZero reports whether the Timestamp is unset.
+func (t Timestamp) IsZero() bool {
+	return t == 0
+}
@@ -0,0 +1,40 @@
+package stripe
+
+import (
+	"encoding/json"
+	"net/url"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestTimestamp(t *testing.T) {
+	now := time.Unix(1424304000, 0)
+
+	ts := NewTimestamp(now)
+	if ts != 1424304000 || ts.IsZero() || !ts.Time().Equal(now) {
+		t.Errorf("NewTimestamp(%v) = %v with time %v", now, ts, ts.Time())
+	}
+
+	if ts := NewTimestamp(time.Time{}); !ts.IsZero() || !ts.Time().IsZero() {
+		t.Errorf("NewTimestamp(zero) = %v with time %v want zero", ts, ts.Time())
+	}
+}
+
+func TestTimestampEncoding(t *testing.T) {
+	var sub Sub
+	if err := json.Unmarshal([]byte(`{"id":"sub_123","current_period_end":1424304000,"canceled_at":null}`), &sub); err != nil {
+		t.Fatalf("Unmarshal() err = %v want nil", err)
+	}
+
+	if sub.PeriodEnd != 1424304000 || !sub.Canceled.IsZero() {
+		t.Errorf("sub = %v, %v want 1424304000, zero", sub.PeriodEnd, sub.Canceled)
+	}
+
+	params := &AccountParams{TosAcceptance: &TosAcceptanceParams{Date: sub.PeriodEnd}}
+	want := url.Values{"tos_acceptance[date]": {"1424304000"}}
+
+	if got := encode(params); !reflect.DeepEqual(got, want) {
+		t.Errorf("values = %v want %v", got, want)
+	}
+}
@@ -8,20 +8,41 @@ type TokenType string
 // For more details see https://stripe.com/docs/api#create_card_token and https://stripe.com/docs/api#create_bank_account_token.
 type TokenParams struct {
 	Params
-	Card     *CardParams
-	Bank     *BankAccountParams
-	Customer string
+	Card     *CardParams        `form:"card"`
+	Bank     *BankAccountParams `form:"bank_account"`
+	Customer string             `form:"customer"`
 	// Email is an undocumented parameter used by Stripe Checkout
 	// It may be removed from the API without notice.
-	Email string
+	Email string `form:"email"`
+}
+
+// Validate checks that the token is created for exactly one payment instrument.
+func (t *TokenParams) Validate() error {
+	v := &validation{}
+	t.Params.validate(v)
+
+	if t.Card != nil && t.Bank != nil {
+		v.add("bank_account", "cannot be used together with a card")
+	}
+
+	if t.Card != nil {
+		v.nested(t.Card.Validate())
+	}
+
+	if t.Bank != nil {
+		v.nested(t.Bank.Validate())
+	}
+
+	return v.err()
 }
 
 // Token is the resource representing a Stripe token.
 // For more details see https://stripe.com/docs/api#tokens.
 type Token struct {
+	Extra
 	ID       string       `json:"id"`
 	Live     bool         `json:"livemode"`
-	Created  int64        `json:"created"`
+	Created  Timestamp    `json:"created"`
 	Type     TokenType    `json:"type"`
 	Used     bool         `json:"used"`
 	Bank     *BankAccount `json:"bank_account"`
@@ -31,3 +52,9 @@ type Token struct {
 	// with Stripe Checkout.
 	Email string `json:"email"`
 }
+
+// UnmarshalJSON handles deserialization of a Token.
+func (t *Token) UnmarshalJSON(data []byte) error {
+	type token Token
+	return unmarshalResource(data, (*token)(t))
+}
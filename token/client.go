@@ -6,6 +6,7 @@ import (
 	"net/url"
 
 	stripe "github.com/channelmeter/stripe-go"
+	"github.com/channelmeter/stripe-go/form"
 )
 
 const (
@@ -17,6 +18,9 @@ const (
 type Client struct {
 	B   stripe.Backend
 	Key string
+	// NoValidate disables the client-side validation of params
+	// that is otherwise done before calling the backend.
+	NoValidate bool
 }
 
 // New POSTs a new card or bank account.
@@ -26,27 +30,19 @@ func New(params *stripe.TokenParams) (*stripe.Token, error) {
 }
 
 func (c Client) New(params *stripe.TokenParams) (*stripe.Token, error) {
+	if err := stripe.ValidateParams(params, c.NoValidate); err != nil {
+		return nil, err
+	}
+
 	body := &url.Values{}
 	token := c.Key
 
-	if len(params.Customer) > 0 {
-		body.Add("customer", params.Customer)
-	}
-
-	if params.Card != nil {
-		params.Card.AppendDetails(body, true)
-	} else if params.Bank != nil {
-		params.Bank.AppendDetails(body)
-	} else if len(params.Customer) == 0 {
+	if params.Card == nil && params.Bank == nil && len(params.Customer) == 0 {
 		err := errors.New("Invalid Token params: either Card or Bank need to be set")
 		return nil, err
 	}
 
-	if len(params.Email) > 0 {
-		body.Add("email", params.Email)
-	}
-
-	params.AppendTo(body)
+	form.AppendTo(body, params)
 
 	tok := &stripe.Token{}
 	err := c.B.Call("POST", "/tokens", token, body, &params.Params, tok)
@@ -77,5 +73,5 @@ func (c Client) Get(id string, params *stripe.TokenParams) (*stripe.Token, error
 }
 
 func getC() Client {
-	return Client{stripe.GetBackend(stripe.APIBackend), stripe.Key}
+	return Client{B: stripe.GetBackend(stripe.APIBackend), Key: stripe.Key}
 }
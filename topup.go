@@ -0,0 +1,87 @@
+package stripe
+
+// TopupStatus is the list of allowed values for the top-up's status.
+// Allowed values are "canceled", "failed", "pending", "reversed", "succeeded".
+type TopupStatus string
+
+// TopupParams is the set of parameters that can be used when creating or updating a top-up.
+// For more details see https://stripe.com/docs/api#create_topup and https://stripe.com/docs/api#update_topup.
+type TopupParams struct {
+	Params
+	Amount    uint64   `form:"amount"`
+	Currency  Currency `form:"currency"`
+	Desc      string   `form:"description"`
+	Source    string   `form:"source"`
+	Statement string   `form:"statement_descriptor"`
+}
+
+// Validate checks the top-up parameters before they are sent.
+func (p *TopupParams) Validate() error {
+	v := &validation{}
+	p.Params.validate(v)
+	v.statement("statement_descriptor", p.Statement, maxTransferStatementLen)
+
+	// amount and currency are only sent when creating a top-up
+	if p.Amount > 0 || len(p.Currency) > 0 {
+		if p.Amount == 0 {
+			v.add("amount", "is required")
+		}
+
+		v.required("currency", string(p.Currency))
+	}
+
+	return v.err()
+}
+
+// TopupListParams is the set of parameters that can be used when listing top-ups.
+// For more details see https://stripe.com/docs/api#list_topups.
+type TopupListParams struct {
+	ListParams
+	Created      Timestamp    `form:"created"`
+	CreatedRange *RangeParams `form:"created"`
+	Status       TopupStatus  `form:"status"`
+}
+
+// Validate checks the top-up list parameters before they are sent.
+func (p *TopupListParams) Validate() error {
+	v := &validation{}
+	p.ListParams.validate(v)
+	v.timeRange("created", p.Created, p.CreatedRange)
+	v.oneOf("status", string(p.Status), "canceled", "failed", "pending", "succeeded")
+	return v.err()
+}
+
+// Topup is the resource representing a Stripe top-up.
+// For more details see https://stripe.com/docs/api#topups.
+type Topup struct {
+	Extra
+	ID        string            `json:"id"`
+	Live      bool              `json:"livemode"`
+	Amount    uint64            `json:"amount"`
+	Tx        *Transaction      `json:"balance_transaction"`
+	Created   Timestamp         `json:"created"`
+	Currency  Currency          `json:"currency"`
+	Desc      string            `json:"description"`
+	Arrival   Timestamp         `json:"expected_availability_date"`
+	FailCode  string            `json:"failure_code"`
+	FailMsg   string            `json:"failure_message"`
+	Meta      map[string]string `json:"metadata"`
+	Source    *PaymentSource    `json:"source"`
+	Statement string            `json:"statement_descriptor"`
+	Status    TopupStatus       `json:"status"`
+	Expanded  bool              `json:"-"`
+}
+
+// TopupList is a list object for top-ups.
+type TopupList struct {
+	ListMeta
+	Values []*Topup `json:"data"`
+}
+
+// UnmarshalJSON handles deserialization of a Topup.
+// This custom unmarshaling is needed because the resulting
+// property may be an id or the full struct if it was expanded.
+func (t *Topup) UnmarshalJSON(data []byte) error {
+	type topup Topup
+	return unmarshalExpandable(data, &t.ID, &t.Expanded, (*topup)(t))
+}
@@ -0,0 +1,169 @@
+// Package topup provides the /topups APIs
+package topup
+
+import (
+	"fmt"
+	"net/url"
+
+	stripe "github.com/channelmeter/stripe-go"
+	"github.com/channelmeter/stripe-go/form"
+)
+
+const (
+	Canceled  stripe.TopupStatus = "canceled"
+	Failed    stripe.TopupStatus = "failed"
+	Pending   stripe.TopupStatus = "pending"
+	Reversed  stripe.TopupStatus = "reversed"
+	Succeeded stripe.TopupStatus = "succeeded"
+)
+
+// Client is used to invoke /topups APIs.
+type Client struct {
+	B   stripe.Backend
+	Key string
+	// NoValidate disables the client-side validation of params
+	// that is otherwise done before calling the backend.
+	NoValidate bool
+}
+
+// New POSTs a new top-up.
+// For more details see https://stripe.com/docs/api#create_topup.
+func New(params *stripe.TopupParams) (*stripe.Topup, error) {
+	return getC().New(params)
+}
+
+func (c Client) New(params *stripe.TopupParams) (*stripe.Topup, error) {
+	if err := stripe.ValidateParams(params, c.NoValidate); err != nil {
+		return nil, err
+	}
+
+	body := &url.Values{}
+	form.AppendTo(body, params)
+
+	topup := &stripe.Topup{}
+	err := c.B.Call("POST", "/topups", c.Key, body, &params.Params, topup)
+
+	return topup, err
+}
+
+// Get returns the details of a top-up.
+// For more details see https://stripe.com/docs/api#retrieve_topup.
+func Get(id string, params *stripe.TopupParams) (*stripe.Topup, error) {
+	return getC().Get(id, params)
+}
+
+func (c Client) Get(id string, params *stripe.TopupParams) (*stripe.Topup, error) {
+	var body *url.Values
+	var commonParams *stripe.Params
+
+	if params != nil {
+		commonParams = &params.Params
+		body = &url.Values{}
+		params.AppendTo(body)
+	}
+
+	topup := &stripe.Topup{}
+	err := c.B.Call("GET", "/topups/"+id, c.Key, body, commonParams, topup)
+
+	return topup, err
+}
+
+// Update updates a top-up's properties.
+// For more details see https://stripe.com/docs/api#update_topup.
+func Update(id string, params *stripe.TopupParams) (*stripe.Topup, error) {
+	return getC().Update(id, params)
+}
+
+func (c Client) Update(id string, params *stripe.TopupParams) (*stripe.Topup, error) {
+	if err := stripe.ValidateParams(params, c.NoValidate); err != nil {
+		return nil, err
+	}
+
+	var body *url.Values
+	var commonParams *stripe.Params
+
+	if params != nil {
+		commonParams = &params.Params
+
+		body = &url.Values{}
+		form.AppendTo(body, params)
+	}
+
+	topup := &stripe.Topup{}
+	err := c.B.Call("POST", "/topups/"+id, c.Key, body, commonParams, topup)
+
+	return topup, err
+}
+
+// Cancel cancels a pending top-up.
+// For more details see https://stripe.com/docs/api#cancel_topup.
+func Cancel(id string, params *stripe.TopupParams) (*stripe.Topup, error) {
+	return getC().Cancel(id, params)
+}
+
+func (c Client) Cancel(id string, params *stripe.TopupParams) (*stripe.Topup, error) {
+	var body *url.Values
+	var commonParams *stripe.Params
+
+	if params != nil {
+		commonParams = &params.Params
+
+		body = &url.Values{}
+		params.AppendTo(body)
+	}
+
+	topup := &stripe.Topup{}
+	err := c.B.Call("POST", fmt.Sprintf("/topups/%v/cancel", id), c.Key, body, commonParams, topup)
+
+	return topup, err
+}
+
+// List returns a list of top-ups.
+// For more details see https://stripe.com/docs/api#list_topups.
+func List(params *stripe.TopupListParams) *Iter {
+	return getC().List(params)
+}
+
+func (c Client) List(params *stripe.TopupListParams) *Iter {
+	if err := stripe.ValidateParams(params, c.NoValidate); err != nil {
+		return &Iter{stripe.GetIterErr(err)}
+	}
+
+	var body *url.Values
+	var lp *stripe.ListParams
+
+	if params != nil {
+		body = &url.Values{}
+		form.AppendTo(body, params)
+		lp = &params.ListParams
+	}
+
+	return &Iter{stripe.GetIter(lp, body, func(b url.Values) ([]interface{}, stripe.ListMeta, error) {
+		list := &stripe.TopupList{}
+		err := c.B.Call("GET", "/topups", c.Key, &b, nil, list)
+
+		ret := make([]interface{}, len(list.Values))
+		for i, v := range list.Values {
+			ret[i] = v
+		}
+
+		return ret, list.ListMeta, err
+	})}
+}
+
+// Iter is an iterator for lists of Topups.
+// The embedded Iter carries methods with it;
+// see its documentation for details.
+type Iter struct {
+	*stripe.Iter
+}
+
+// Topup returns the most recent Topup
+// visited by a call to Next.
+func (i *Iter) Topup() *stripe.Topup {
+	return i.Current().(*stripe.Topup)
+}
+
+func getC() Client {
+	return Client{B: stripe.GetBackend(stripe.APIBackend), Key: stripe.Key}
+}
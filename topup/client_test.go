@@ -0,0 +1,63 @@
+package topup
+
+import (
+	"testing"
+
+	stripe "github.com/channelmeter/stripe-go"
+	"github.com/channelmeter/stripe-go/currency"
+	. "github.com/channelmeter/stripe-go/utils"
+)
+
+func init() {
+	stripe.Key = GetTestKey()
+}
+
+func TestTopupNew(t *testing.T) {
+	params := &stripe.TopupParams{
+		Amount:    2000,
+		Currency:  currency.USD,
+		Desc:      "Top-up test",
+		Source:    "btok_us_verified",
+		Statement: "Top-up",
+	}
+
+	target, err := New(params)
+
+	if err != nil {
+		t.Error(err)
+	}
+
+	if target.Amount != params.Amount {
+		t.Errorf("Amount %v does not match expected amount %v\n", target.Amount, params.Amount)
+	}
+
+	if target.Desc != params.Desc {
+		t.Errorf("Description %q does not match expected description %q\n", target.Desc, params.Desc)
+	}
+
+	target, err = Get(target.ID, nil)
+
+	if err != nil {
+		t.Error(err)
+	}
+
+	if target.Status != Pending && target.Status != Succeeded {
+		t.Errorf("Unexpected status %q\n", target.Status)
+	}
+}
+
+func TestTopupList(t *testing.T) {
+	params := &stripe.TopupListParams{}
+	params.Filters.AddFilter("limit", "", "5")
+	params.Single = true
+
+	i := List(params)
+	for i.Next() {
+		if i.Topup() == nil {
+			t.Error("No nil values expected")
+		}
+	}
+	if err := i.Err(); err != nil {
+		t.Error(err)
+	}
+}
@@ -18,30 +18,78 @@ type TransferFailCode string
 // For more details see https://stripe.com/docs/api#create_transfer and https://stripe.com/docs/api#update_transfer.
 type TransferParams struct {
 	Params
-	Amount                                                             int64
-	Fee                                                                uint64
-	Currency                                                           Currency
-	Recipient, Desc, Statement, Bank, Card, SourceTx, Dest, SourceType string
+	Amount     int64    `form:"amount"`
+	Fee        uint64   `form:"application_fee"`
+	Currency   Currency `form:"currency"`
+	Recipient  string   `form:"recipient"`
+	Desc       string   `form:"description"`
+	Statement  string   `form:"statement_descriptor"`
+	Bank       string   `form:"bank_account"`
+	Card       string   `form:"card"`
+	SourceTx   string   `form:"source_transaction"`
+	Dest       string   `form:"destination"`
+	SourceType string   `form:"source_type"`
+}
+
+// Validate checks the transfer parameters before they are sent.
+func (p *TransferParams) Validate() error {
+	v := &validation{}
+	p.Params.validate(v)
+	v.statement("statement_descriptor", p.Statement, maxTransferStatementLen)
+
+	// amount and currency are only sent when creating a transfer
+	if p.Amount != 0 || len(p.Currency) > 0 {
+		if p.Amount <= 0 {
+			v.add("amount", "must be a positive integer")
+		}
+
+		v.required("currency", string(p.Currency))
+
+		if len(p.Recipient) == 0 && len(p.Dest) == 0 {
+			v.add("destination", "either recipient or destination must be set")
+		}
+	}
+
+	if len(p.Bank) > 0 && len(p.Card) > 0 {
+		v.add("card", "cannot be used together with bank_account")
+	}
+
+	v.oneOf("source_type", p.SourceType, "card", "bank_account", "bitcoin_receiver")
+
+	return v.err()
 }
 
 // TransferListParams is the set of parameters that can be used when listing transfers.
 // For more details see https://stripe.com/docs/api#list_transfers.
 type TransferListParams struct {
 	ListParams
-	Created, Date int64
-	Recipient     string
-	Status        TransferStatus
+	Created      Timestamp      `form:"created"`
+	CreatedRange *RangeParams   `form:"created"`
+	Date         Timestamp      `form:"date"`
+	DateRange    *RangeParams   `form:"date"`
+	Recipient    string         `form:"recipient"`
+	Status       TransferStatus `form:"status"`
+}
+
+// Validate checks the transfer list parameters before they are sent.
+func (p *TransferListParams) Validate() error {
+	v := &validation{}
+	p.ListParams.validate(v)
+	v.timeRange("created", p.Created, p.CreatedRange)
+	v.timeRange("date", p.Date, p.DateRange)
+	return v.err()
 }
 
 // Transfer is the resource representing a Stripe transfer.
 // For more details see https://stripe.com/docs/api#transfers.
 type Transfer struct {
+	Extra
 	ID        string            `json:"id"`
 	Live      bool              `json:"livemode"`
 	Amount    int64             `json:"amount"`
 	Currency  Currency          `json:"currency"`
-	Created   int64             `json:"created"`
-	Date      int64             `json:"date"`
+	Created   Timestamp         `json:"created"`
+	Date      Timestamp         `json:"date"`
 	Desc      string            `json:"description"`
 	FailCode  TransferFailCode  `json:"failure_code"`
 	FailMsg   string            `json:"failure_message"`
@@ -55,3 +103,9 @@ type Transfer struct {
 	Statement string            `json:"statement_descriptor"`
 	Reversals *ReversalList     `json:"reversals"`
 }
+
+// UnmarshalJSON handles deserialization of a Transfer.
+func (t *Transfer) UnmarshalJSON(data []byte) error {
+	type transfer Transfer
+	return unmarshalResource(data, (*transfer)(t))
+}
@@ -4,9 +4,9 @@ package transfer
 import (
 	"fmt"
 	"net/url"
-	"strconv"
 
 	stripe "github.com/channelmeter/stripe-go"
+	"github.com/channelmeter/stripe-go/form"
 )
 
 const (
@@ -34,6 +34,9 @@ const (
 type Client struct {
 	B   stripe.Backend
 	Key string
+	// NoValidate disables the client-side validation of params
+	// that is otherwise done before calling the backend.
+	NoValidate bool
 }
 
 // New POSTs a new transfer.
@@ -43,45 +46,12 @@ func New(params *stripe.TransferParams) (*stripe.Transfer, error) {
 }
 
 func (c Client) New(params *stripe.TransferParams) (*stripe.Transfer, error) {
-	body := &url.Values{
-		"amount":   {strconv.FormatInt(params.Amount, 10)},
-		"currency": {string(params.Currency)},
+	if err := stripe.ValidateParams(params, c.NoValidate); err != nil {
+		return nil, err
 	}
 
-	if len(params.Recipient) > 0 {
-		body.Add("recipient", params.Recipient)
-	}
-
-	if len(params.Bank) > 0 {
-		body.Add("bank_account", params.Bank)
-	} else if len(params.Card) > 0 {
-		body.Add("card", params.Card)
-	}
-
-	if len(params.Desc) > 0 {
-		body.Add("description", params.Desc)
-	}
-
-	if len(params.Statement) > 0 {
-		body.Add("statement_descriptor", params.Statement)
-	}
-
-	if len(params.Dest) > 0 {
-		body.Add("destination", params.Dest)
-	}
-
-	if len(params.SourceTx) > 0 {
-		body.Add("source_transaction", params.SourceTx)
-	}
-
-	if len(params.SourceType) > 0 {
-		body.Add("source_type", params.SourceType)
-	}
-
-	if params.Fee > 0 {
-		body.Add("application_fee", strconv.FormatUint(params.Fee, 10))
-	}
-	params.AppendTo(body)
+	body := &url.Values{}
+	form.AppendTo(body, params)
 
 	transfer := &stripe.Transfer{}
 	err := c.B.Call("POST", "/transfers", c.Key, body, &params.Params, transfer)
@@ -118,6 +88,10 @@ func Update(id string, params *stripe.TransferParams) (*stripe.Transfer, error)
 }
 
 func (c Client) Update(id string, params *stripe.TransferParams) (*stripe.Transfer, error) {
+	if err := stripe.ValidateParams(params, c.NoValidate); err != nil {
+		return nil, err
+	}
+
 	var body *url.Values
 	var commonParams *stripe.Params
 
@@ -125,12 +99,7 @@ func (c Client) Update(id string, params *stripe.TransferParams) (*stripe.Transf
 		commonParams = &params.Params
 
 		body = &url.Values{}
-
-		if len(params.Desc) > 0 {
-			body.Add("description", params.Desc)
-		}
-
-		params.AppendTo(body)
+		form.AppendTo(body, params)
 	}
 
 	transfer := &stripe.Transfer{}
@@ -169,6 +138,10 @@ func List(params *stripe.TransferListParams) *Iter {
 }
 
 func (c Client) List(params *stripe.TransferListParams) *Iter {
+	if err := stripe.ValidateParams(params, c.NoValidate); err != nil {
+		return &Iter{stripe.GetIterErr(err)}
+	}
+
 	type transferList struct {
 		stripe.ListMeta
 		Values []*stripe.Transfer `json:"data"`
@@ -179,24 +152,7 @@ func (c Client) List(params *stripe.TransferListParams) *Iter {
 
 	if params != nil {
 		body = &url.Values{}
-
-		if params.Created > 0 {
-			body.Add("created", strconv.FormatInt(params.Created, 10))
-		}
-
-		if params.Date > 0 {
-			body.Add("date", strconv.FormatInt(params.Date, 10))
-		}
-
-		if len(params.Recipient) > 0 {
-			body.Add("recipient", params.Recipient)
-		}
-
-		if len(params.Status) > 0 {
-			body.Add("status", string(params.Status))
-		}
-
-		params.AppendTo(body)
+		form.AppendTo(body, params)
 		lp = &params.ListParams
 	}
 
@@ -227,5 +183,5 @@ func (i *Iter) Transfer() *stripe.Transfer {
 }
 
 func getC() Client {
-	return Client{stripe.GetBackend(stripe.APIBackend), stripe.Key}
+	return Client{B: stripe.GetBackend(stripe.APIBackend), Key: stripe.Key}
 }
@@ -0,0 +1,88 @@
+package stripe
+
+// UsageRecordAction is the list of allowed values for how a usage record
+// changes the usage of its period.
+// Allowed values are "increment", "set".
+type UsageRecordAction string
+
+// UsageRecordParams is the set of parameters that can be used when creating a usage record.
+// The quantity is always sent, so that a usage can be set to zero.
+// For more details see https://stripe.com/docs/api#usage_record_create.
+type UsageRecordParams struct {
+	Params
+	SubItem   string            `form:"-"`
+	Quantity  uint64            `form:"quantity,zero"`
+	Timestamp Timestamp         `form:"timestamp"`
+	Action    UsageRecordAction `form:"action"`
+}
+
+// Validate checks the usage record parameters before they are sent.
+func (p *UsageRecordParams) Validate() error {
+	v := &validation{}
+	p.Params.validate(v)
+	v.required("subscription_item", p.SubItem)
+	v.oneOf("action", string(p.Action), "increment", "set")
+
+	if p.Timestamp.IsZero() {
+		v.add("timestamp", "is required")
+	}
+
+	return v.err()
+}
+
+// UsageRecord is the resource representing a Stripe usage record.
+// For more details see https://stripe.com/docs/api#usage_records.
+type UsageRecord struct {
+	Extra
+	ID        string    `json:"id"`
+	Live      bool      `json:"livemode"`
+	Quantity  uint64    `json:"quantity"`
+	SubItem   string    `json:"subscription_item"`
+	Timestamp Timestamp `json:"timestamp"`
+}
+
+// UnmarshalJSON handles deserialization of a UsageRecord.
+func (u *UsageRecord) UnmarshalJSON(data []byte) error {
+	type usageRecord UsageRecord
+	return unmarshalResource(data, (*usageRecord)(u))
+}
+
+// UsageRecordSummaryListParams is the set of parameters that can be used when listing the usage record summaries of a subscription item.
+// For more details see https://stripe.com/docs/api#usage_record_summary_list.
+type UsageRecordSummaryListParams struct {
+	ListParams
+	SubItem string `form:"-"`
+}
+
+// Validate checks the usage record summary list parameters before they are sent.
+func (p *UsageRecordSummaryListParams) Validate() error {
+	v := &validation{}
+	p.ListParams.validate(v)
+	v.required("subscription_item", p.SubItem)
+	return v.err()
+}
+
+// UsageRecordSummary is the resource representing the usage of a
+// subscription item during a billing period.
+// For more details see https://stripe.com/docs/api#usage_record_summary_object.
+type UsageRecordSummary struct {
+	Extra
+	ID         string  `json:"id"`
+	Live       bool    `json:"livemode"`
+	Invoice    string  `json:"invoice"`
+	Period     *Period `json:"period"`
+	SubItem    string  `json:"subscription_item"`
+	TotalUsage uint64  `json:"total_usage"`
+}
+
+// UsageRecordSummaryList is a list object for usage record summaries.
+type UsageRecordSummaryList struct {
+	ListMeta
+	Values []*UsageRecordSummary `json:"data"`
+}
+
+// UnmarshalJSON handles deserialization of a UsageRecordSummary.
+func (u *UsageRecordSummary) UnmarshalJSON(data []byte) error {
+	type usageRecordSummary UsageRecordSummary
+	return unmarshalResource(data, (*usageRecordSummary)(u))
+}
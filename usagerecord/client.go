@@ -0,0 +1,55 @@
+// Package usagerecord provides the /subscription_items/usage_records APIs
+package usagerecord
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+
+	stripe "github.com/channelmeter/stripe-go"
+	"github.com/channelmeter/stripe-go/form"
+)
+
+const (
+	Increment stripe.UsageRecordAction = "increment"
+	Set       stripe.UsageRecordAction = "set"
+)
+
+// Client is used to invoke /subscription_items/usage_records APIs.
+type Client struct {
+	B   stripe.Backend
+	Key string
+	// NoValidate disables the client-side validation of params
+	// that is otherwise done before calling the backend.
+	NoValidate bool
+}
+
+// New POSTs a new usage record for a subscription item.
+// For more details see https://stripe.com/docs/api#usage_record_create.
+func New(params *stripe.UsageRecordParams) (*stripe.UsageRecord, error) {
+	return getC().New(params)
+}
+
+func (c Client) New(params *stripe.UsageRecordParams) (*stripe.UsageRecord, error) {
+	if err := stripe.ValidateParams(params, c.NoValidate); err != nil {
+		return nil, err
+	}
+
+	body := &url.Values{}
+	form.AppendTo(body, params)
+
+	record := &stripe.UsageRecord{}
+	var err error
+
+	if len(params.SubItem) > 0 {
+		err = c.B.Call("POST", fmt.Sprintf("/subscription_items/%v/usage_records", params.SubItem), c.Key, body, &params.Params, record)
+	} else {
+		err = errors.New("Invalid usage record params: subscription item needs to be set")
+	}
+
+	return record, err
+}
+
+func getC() Client {
+	return Client{B: stripe.GetBackend(stripe.APIBackend), Key: stripe.Key}
+}
@@ -0,0 +1,84 @@
+package usagerecord
+
+import (
+	"testing"
+	"time"
+
+	stripe "github.com/channelmeter/stripe-go"
+	"github.com/channelmeter/stripe-go/currency"
+	"github.com/channelmeter/stripe-go/customer"
+	"github.com/channelmeter/stripe-go/plan"
+	"github.com/channelmeter/stripe-go/sub"
+	"github.com/channelmeter/stripe-go/usagerecordsummary"
+	. "github.com/channelmeter/stripe-go/utils"
+)
+
+func init() {
+	stripe.Key = GetTestKey()
+}
+
+func TestUsageRecordNew(t *testing.T) {
+	cust, _ := customer.New(&stripe.CustomerParams{
+		Source: &stripe.SourceParams{Token: "tok_visa"},
+	})
+
+	plan.New(&stripe.PlanParams{
+		ID:             "test_metered",
+		Name:           "Test Metered Plan",
+		Amount:         10,
+		Currency:       currency.USD,
+		Interval:       plan.Month,
+		UsageType:      plan.Metered,
+		AggregateUsage: plan.Sum,
+	})
+
+	s, _ := sub.New(&stripe.SubParams{
+		Customer: cust.ID,
+		Items:    []*stripe.SubItemsParams{{Plan: "test_metered"}},
+	})
+
+	item := s.Items.Values[0]
+
+	params := &stripe.UsageRecordParams{
+		SubItem:   item.ID,
+		Quantity:  25,
+		Timestamp: stripe.NewTimestamp(time.Now()),
+		Action:    Increment,
+	}
+
+	target, err := New(params)
+
+	if err != nil {
+		t.Error(err)
+	}
+
+	if target.Quantity != params.Quantity {
+		t.Errorf("Quantity %v does not match expected quantity %v\n", target.Quantity, params.Quantity)
+	}
+
+	if target.SubItem != item.ID {
+		t.Errorf("Subscription item %q does not match expected item %q\n", target.SubItem, item.ID)
+	}
+
+	i := usagerecordsummary.List(&stripe.UsageRecordSummaryListParams{SubItem: item.ID})
+	for i.Next() {
+		if i.UsageRecordSummary().TotalUsage != params.Quantity {
+			t.Errorf("Total usage %v does not match expected usage %v\n", i.UsageRecordSummary().TotalUsage, params.Quantity)
+		}
+	}
+	if err := i.Err(); err != nil {
+		t.Error(err)
+	}
+
+	customer.Del(cust.ID)
+	plan.Del("test_metered")
+}
+
+func TestUsageRecordNewNoSubItem(t *testing.T) {
+	c := Client{B: stripe.GetBackend(stripe.APIBackend), Key: stripe.Key, NoValidate: true}
+
+	_, err := c.New(&stripe.UsageRecordParams{Quantity: 25, Action: Increment})
+	if err == nil {
+		t.Errorf("Creating a usage record without a subscription item should have failed\n")
+	}
+}
@@ -0,0 +1,72 @@
+// Package usagerecordsummary provides the /subscription_items/usage_record_summaries APIs
+package usagerecordsummary
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+
+	stripe "github.com/channelmeter/stripe-go"
+	"github.com/channelmeter/stripe-go/form"
+)
+
+// Client is used to invoke /subscription_items/usage_record_summaries APIs.
+type Client struct {
+	B   stripe.Backend
+	Key string
+	// NoValidate disables the client-side validation of params
+	// that is otherwise done before calling the backend.
+	NoValidate bool
+}
+
+// List returns a list of the usage record summaries of a subscription item,
+// one for each of its billing periods.
+// For more details see https://stripe.com/docs/api#usage_record_summary_list.
+func List(params *stripe.UsageRecordSummaryListParams) *Iter {
+	return getC().List(params)
+}
+
+func (c Client) List(params *stripe.UsageRecordSummaryListParams) *Iter {
+	if err := stripe.ValidateParams(params, c.NoValidate); err != nil {
+		return &Iter{stripe.GetIterErr(err)}
+	}
+
+	if len(params.SubItem) == 0 {
+		return &Iter{stripe.GetIterErr(errors.New("Invalid usage record summary params: subscription item needs to be set"))}
+	}
+
+	body := &url.Values{}
+	var lp *stripe.ListParams
+
+	form.AppendTo(body, params)
+	lp = &params.ListParams
+
+	return &Iter{stripe.GetIter(lp, body, func(b url.Values) ([]interface{}, stripe.ListMeta, error) {
+		list := &stripe.UsageRecordSummaryList{}
+		err := c.B.Call("GET", fmt.Sprintf("/subscription_items/%v/usage_record_summaries", params.SubItem), c.Key, &b, nil, list)
+
+		ret := make([]interface{}, len(list.Values))
+		for i, v := range list.Values {
+			ret[i] = v
+		}
+
+		return ret, list.ListMeta, err
+	})}
+}
+
+// Iter is an iterator for lists of UsageRecordSummaries.
+// The embedded Iter carries methods with it;
+// see its documentation for details.
+type Iter struct {
+	*stripe.Iter
+}
+
+// UsageRecordSummary returns the most recent UsageRecordSummary
+// visited by a call to Next.
+func (i *Iter) UsageRecordSummary() *stripe.UsageRecordSummary {
+	return i.Current().(*stripe.UsageRecordSummary)
+}
+
+func getC() Client {
+	return Client{B: stripe.GetBackend(stripe.APIBackend), Key: stripe.Key}
+}
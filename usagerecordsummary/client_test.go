@@ -0,0 +1,93 @@
+package usagerecordsummary
+
+import (
+	"testing"
+	"time"
+
+	stripe "github.com/channelmeter/stripe-go"
+	"github.com/channelmeter/stripe-go/currency"
+	"github.com/channelmeter/stripe-go/customer"
+	"github.com/channelmeter/stripe-go/plan"
+	"github.com/channelmeter/stripe-go/sub"
+	"github.com/channelmeter/stripe-go/usagerecord"
+	. "github.com/channelmeter/stripe-go/utils"
+)
+
+func init() {
+	stripe.Key = GetTestKey()
+}
+
+func TestUsageRecordSummaryList(t *testing.T) {
+	cust, _ := customer.New(&stripe.CustomerParams{
+		Source: &stripe.SourceParams{Token: "tok_visa"},
+	})
+
+	plan.New(&stripe.PlanParams{
+		ID:             "test_metered_summary",
+		Name:           "Test Metered Summary Plan",
+		Amount:         10,
+		Currency:       currency.USD,
+		Interval:       plan.Month,
+		UsageType:      plan.Metered,
+		AggregateUsage: plan.Sum,
+	})
+
+	s, _ := sub.New(&stripe.SubParams{
+		Customer: cust.ID,
+		Items:    []*stripe.SubItemsParams{{Plan: "test_metered_summary"}},
+	})
+
+	item := s.Items.Values[0]
+
+	for _, quantity := range []uint64{10, 15} {
+		_, err := usagerecord.New(&stripe.UsageRecordParams{
+			SubItem:   item.ID,
+			Quantity:  quantity,
+			Timestamp: stripe.NewTimestamp(time.Now()),
+			Action:    usagerecord.Increment,
+		})
+
+		if err != nil {
+			t.Error(err)
+		}
+	}
+
+	i := List(&stripe.UsageRecordSummaryListParams{SubItem: item.ID})
+	count := 0
+	for i.Next() {
+		target := i.UsageRecordSummary()
+
+		if target.SubItem != item.ID {
+			t.Errorf("Subscription item %q does not match expected item %q\n", target.SubItem, item.ID)
+		}
+
+		if target.Period == nil {
+			t.Errorf("Period is not set\n")
+		}
+
+		count++
+	}
+	if err := i.Err(); err != nil {
+		t.Error(err)
+	}
+
+	if count == 0 {
+		t.Errorf("No usage record summaries were listed\n")
+	}
+
+	customer.Del(cust.ID)
+	plan.Del("test_metered_summary")
+}
+
+func TestUsageRecordSummaryListNoSubItem(t *testing.T) {
+	c := Client{B: stripe.GetBackend(stripe.APIBackend), Key: stripe.Key, NoValidate: true}
+
+	i := c.List(&stripe.UsageRecordSummaryListParams{})
+	if i.Next() {
+		t.Errorf("Listing without a subscription item should not return any summary\n")
+	}
+
+	if i.Err() == nil {
+		t.Errorf("Listing without a subscription item should have failed\n")
+	}
+}
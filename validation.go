@@ -0,0 +1,246 @@
+package stripe
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	maxMetaKeys             = 20
+	maxMetaKeyLen           = 40
+	maxMetaValueLen         = 500
+	maxStatementLen         = 22
+	maxTransferStatementLen = 15
+	invalidStatement        = `<>"'`
+)
+
+// Validator is implemented by every *Params type. Clients call Validate
+// before sending a request unless validation is disabled on the client.
+type Validator interface {
+	Validate() error
+}
+
+// ValidateParams is used by the resource clients to check params before
+// calling the backend. Nil params are not checked, nor are any params when
+// disabled is set.
+func ValidateParams(p Validator, disabled bool) error {
+	if disabled || p == nil || reflect.ValueOf(p).IsNil() {
+		return nil
+	}
+
+	return p.Validate()
+}
+
+// ParamError describes a single invalid parameter. Param is the name
+// of the parameter as sent to the API, e.g. "transfer_schedule[interval]".
+type ParamError struct {
+	Param string
+	Msg   string
+}
+
+// ValidationError is returned when params fail client-side validation.
+// It lists every problem found rather than only the first one.
+type ValidationError struct {
+	Errors []*ParamError
+}
+
+// Error returns all the problems found, separated by semicolons.
+func (e *ValidationError) Error() string {
+	msgs := make([]string, len(e.Errors))
+	for i, pe := range e.Errors {
+		msgs[i] = fmt.Sprintf("%v: %v", pe.Param, pe.Msg)
+	}
+
+	return "Invalid params: " + strings.Join(msgs, "; ")
+}
+
+// Params returns the names of the invalid parameters.
+func (e *ValidationError) Params() []string {
+	names := make([]string, len(e.Errors))
+	for i, pe := range e.Errors {
+		names[i] = pe.Param
+	}
+
+	return names
+}
+
+// validation accumulates the problems found while checking params.
+type validation struct {
+	errs []*ParamError
+}
+
+func (v *validation) add(param, format string, args ...interface{}) {
+	v.errs = append(v.errs, &ParamError{Param: param, Msg: fmt.Sprintf(format, args...)})
+}
+
+func (v *validation) required(param, val string) {
+	if len(strings.TrimSpace(val)) == 0 {
+		v.add(param, "is required")
+	}
+}
+
+func (v *validation) maxLen(param, val string, n int) {
+	if utf8.RuneCountInString(val) > n {
+		v.add(param, "must be at most %v characters", n)
+	}
+}
+
+func (v *validation) oneOf(param, val string, allowed ...string) {
+	if len(val) == 0 {
+		return
+	}
+
+	for _, a := range allowed {
+		if val == a {
+			return
+		}
+	}
+
+	v.add(param, "must be one of %v", strings.Join(allowed, ", "))
+}
+
+func (v *validation) percent(param string, val float64) {
+	if val < 0 || val > 100 {
+		v.add(param, "must be between 0 and 100")
+	}
+}
+
+func (v *validation) statement(param, val string, n int) {
+	v.maxLen(param, val, n)
+
+	if strings.ContainsAny(val, invalidStatement) {
+		v.add(param, "must not contain any of %v", invalidStatement)
+	}
+}
+
+// timeRange checks a range filter and that it is not used together with
+// the exact value of the same param.
+func (v *validation) timeRange(param string, exact Timestamp, r *RangeParams) {
+	if r == nil {
+		return
+	}
+
+	if exact > 0 {
+		v.add(param, "cannot filter on both an exact value and a range")
+	}
+
+	if !r.GreaterThan.IsZero() && !r.GreaterThanOrEqual.IsZero() {
+		v.add(param+"[gte]", "cannot be used together with %v[gt]", param)
+	}
+
+	if !r.LesserThan.IsZero() && !r.LesserThanOrEqual.IsZero() {
+		v.add(param+"[lte]", "cannot be used together with %v[lt]", param)
+	}
+
+	lower, upper := r.GreaterThan, r.LesserThan
+	if lower.IsZero() {
+		lower = r.GreaterThanOrEqual
+	}
+	if upper.IsZero() {
+		upper = r.LesserThanOrEqual
+	}
+
+	if !lower.IsZero() && !upper.IsZero() && upper < lower {
+		v.add(param, "range ends before it starts")
+	}
+}
+
+// interval checks a billing interval and its count, which is at most a year.
+func (v *validation) interval(param string, interval PlanInterval, countParam string, count uint64) {
+	v.oneOf(param, string(interval), "day", "week", "month", "year")
+
+	maxCount := map[PlanInterval]uint64{"week": 52, "month": 12, "year": 1}
+	if max, ok := maxCount[interval]; ok && count > max {
+		v.add(countParam, "must be at most %v for a %v interval", max, interval)
+	}
+}
+
+// nested adds the problems found in a nested params struct.
+func (v *validation) nested(err error) {
+	if verr, ok := err.(*ValidationError); ok {
+		v.errs = append(v.errs, verr.Errors...)
+	}
+}
+
+func (v *validation) err() error {
+	if len(v.errs) == 0 {
+		return nil
+	}
+
+	return &ValidationError{Errors: v.errs}
+}
+
+// validate checks the common parameters.
+func (p *Params) validate(v *validation) {
+	if len(p.Meta) > maxMetaKeys {
+		v.add("metadata", "must have at most %v keys", maxMetaKeys)
+	}
+
+	// the keys are sorted so that the errors are always in the same order
+	keys := make([]string, 0, len(p.Meta))
+	for k := range p.Meta {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		val := p.Meta[k]
+		if utf8.RuneCountInString(k) > maxMetaKeyLen {
+			v.add(fmt.Sprintf("metadata[%v]", k), "key must be at most %v characters", maxMetaKeyLen)
+		}
+
+		if utf8.RuneCountInString(val) > maxMetaValueLen {
+			v.add(fmt.Sprintf("metadata[%v]", k), "value must be at most %v characters", maxMetaValueLen)
+		}
+	}
+
+	if len(p.IdempotencyKey) > 255 {
+		v.add("Idempotency-Key", "must be at most 255 characters")
+	}
+}
+
+// validate checks the common list parameters.
+func (p *ListParams) validate(v *validation) {
+	if len(p.Start) > 0 && len(p.End) > 0 {
+		v.add(endbefore, "cannot be used together with %v", startafter)
+	}
+
+	if p.Limit < 0 {
+		v.add("limit", "must not be negative")
+	}
+
+	if len(p.Cursor) > 0 {
+		if _, err := decodeCursor(p.Cursor); err != nil {
+			v.add("cursor", "is invalid")
+		}
+
+		if len(p.Start) > 0 || len(p.End) > 0 || p.Shard != nil {
+			v.add("cursor", "cannot be used together with %v, %v or a shard", startafter, endbefore)
+		}
+	}
+
+	if p.Shard != nil {
+		p.Shard.validate(v)
+
+		if len(p.Start) > 0 || len(p.End) > 0 {
+			v.add("shard", "cannot be used together with %v or %v", startafter, endbefore)
+		}
+	}
+}
+
+// Validate checks the common parameters.
+func (p *Params) Validate() error {
+	v := &validation{}
+	p.validate(v)
+	return v.err()
+}
+
+// Validate checks the common list parameters.
+func (p *ListParams) Validate() error {
+	v := &validation{}
+	p.validate(v)
+	return v.err()
+}
@@ -0,0 +1,478 @@
+package stripe
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func validationParams(t *testing.T, err error) []string {
+	verr, ok := err.(*ValidationError)
+	if !ok {
+		t.Fatalf("err = %v want a *ValidationError", err)
+	}
+
+	return verr.Params()
+}
+
+func TestValidateReportsAllProblems(t *testing.T) {
+	params := &CouponParams{
+		Duration:       "repeating",
+		Amount:         100,
+		Percent:        10,
+		DurationPeriod: 0,
+	}
+
+	got := validationParams(t, params.Validate())
+	want := []string{"percent_off", "currency", "duration_in_months"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("params = %v want %v", got, want)
+	}
+}
+
+func TestValidateMetadataOrder(t *testing.T) {
+	long := strings.Repeat("x", 41)
+	params := &CustomerParams{}
+	params.Meta = map[string]string{"c" + long: "", "a" + long: "", "b" + long: ""}
+
+	want := []string{"metadata[a" + long + "]", "metadata[b" + long + "]", "metadata[c" + long + "]"}
+	for i := 0; i < 10; i++ {
+		got := validationParams(t, params.Validate())
+		if !reflect.DeepEqual(got, want) {
+			t.Fatalf("params = %v want %v", got, want)
+		}
+	}
+}
+
+func TestValidateStatementDescriptor(t *testing.T) {
+	params := &ChargeParams{
+		Amount:    100,
+		Currency:  "usd",
+		Customer:  "cus_123",
+		Statement: strings.Repeat("x", 23),
+	}
+
+	got := validationParams(t, params.Validate())
+	if !reflect.DeepEqual(got, []string{"statement_descriptor"}) {
+		t.Errorf("params = %v want statement_descriptor", got)
+	}
+
+	params.Statement = "Go Stripe"
+	if err := params.Validate(); err != nil {
+		t.Errorf("err = %v want nil", err)
+	}
+
+	// updates only send the description and fraud details
+	if err := (&ChargeParams{Desc: "updated"}).Validate(); err != nil {
+		t.Errorf("err = %v want nil", err)
+	}
+}
+
+func TestValidatePlan(t *testing.T) {
+	params := &PlanParams{ID: "gold", Name: "Gold", Amount: 2000, Currency: "usd"}
+
+	got := validationParams(t, params.Validate())
+	if !reflect.DeepEqual(got, []string{"interval"}) {
+		t.Errorf("params = %v want interval", got)
+	}
+
+	if err := (&PlanParams{Name: "Platinum"}).Validate(); err != nil {
+		t.Errorf("err = %v want nil for an update", err)
+	}
+}
+
+func TestValidateTransferSchedule(t *testing.T) {
+	params := &AccountParams{
+		TransferSchedule: &TransferScheduleParams{Interval: Day, WeekAnchor: "friday", MonthAnchor: 3},
+	}
+
+	got := validationParams(t, params.Validate())
+	want := []string{"transfer_schedule[weekly_anchor]", "transfer_schedule[monthly_anchor]"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("params = %v want %v", got, want)
+	}
+}
+
+func TestValidateNestedCard(t *testing.T) {
+	params := &CustomerParams{Source: &SourceParams{Card: &CardParams{Number: "4242424242424242"}}}
+
+	got := validationParams(t, params.Validate())
+	want := []string{"card[exp_month]", "card[exp_year]"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("params = %v want %v", got, want)
+	}
+}
+
+func TestValidateList(t *testing.T) {
+	params := &SubListParams{ListParams: ListParams{Start: "a", End: "b"}}
+
+	got := validationParams(t, params.Validate())
+	want := []string{endbefore, "customer"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("params = %v want %v", got, want)
+	}
+}
+
+func TestValidateShard(t *testing.T) {
+	params := &ChargeListParams{ListParams: ListParams{Start: "ch_123", Shard: &ShardParams{Workers: -1}}}
+
+	got := validationParams(t, params.Validate())
+	want := []string{"shard", "shard", "shard"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("params = %v want %v", got, want)
+	}
+}
+
+func TestValidateRange(t *testing.T) {
+	start := time.Date(2015, time.June, 1, 0, 0, 0, 0, time.UTC)
+
+	params := &TransferListParams{
+		Created:      NewTimestamp(start),
+		CreatedRange: Since(start),
+		DateRange: &RangeParams{
+			GreaterThan:        NewTimestamp(start),
+			GreaterThanOrEqual: NewTimestamp(start),
+			LesserThan:         NewTimestamp(start.AddDate(0, 0, -1)),
+		},
+	}
+
+	got := validationParams(t, params.Validate())
+	want := []string{"created", "date[gte]", "date"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("params = %v want %v", got, want)
+	}
+}
+
+func TestValidateCreatedRange(t *testing.T) {
+	start := time.Date(2015, time.June, 1, 0, 0, 0, 0, time.UTC)
+	reversed := &RangeParams{GreaterThan: NewTimestamp(start), LesserThan: NewTimestamp(start.AddDate(0, 0, -1))}
+
+	valid := []Validator{
+		&CouponListParams{CreatedRange: Since(start)},
+		&PlanListParams{CreatedRange: Between(start, start.AddDate(0, 1, 0))},
+		&FileUploadListParams{Purpose: "dispute_evidence", CreatedRange: Before(start)},
+	}
+
+	for _, p := range valid {
+		if err := p.Validate(); err != nil {
+			t.Errorf("Validate(%+v) err = %v want nil", p, err)
+		}
+	}
+
+	invalid := []Validator{
+		&CouponListParams{CreatedRange: reversed},
+		&PlanListParams{Created: NewTimestamp(start), CreatedRange: Since(start)},
+		&FileUploadListParams{CreatedRange: reversed},
+	}
+
+	for _, p := range invalid {
+		if err := p.Validate(); err == nil {
+			t.Errorf("Validate(%+v) err = nil want an error", p)
+		}
+	}
+}
+
+func TestValidateParams(t *testing.T) {
+	var params *ChargeParams
+	if err := ValidateParams(params, false); err != nil {
+		t.Errorf("nil params err = %v want nil", err)
+	}
+
+	params = &ChargeParams{Amount: 100}
+	if err := ValidateParams(params, true); err != nil {
+		t.Errorf("disabled err = %v want nil", err)
+	}
+
+	if err := ValidateParams(params, false); err == nil {
+		t.Errorf("expected an error")
+	}
+
+	if err := ValidateParams(&BalanceParams{}, false); err != nil {
+		t.Errorf("err = %v want nil", err)
+	}
+}
+
+func TestGetIterErr(t *testing.T) {
+	it := GetIterErr(errTest)
+	if it.Next() {
+		t.Errorf("Next = true want false")
+	}
+
+	if it.Err() != errTest {
+		t.Errorf("err = %v want %v", it.Err(), errTest)
+	}
+}
+
+func TestValidatePaymentIntent(t *testing.T) {
+	if err := (&PaymentIntentParams{Amount: 100, Currency: "usd"}).Validate(); err != nil {
+		t.Errorf("Validate() err = %v want nil", err)
+	}
+
+	for _, p := range []*PaymentIntentParams{
+		{Amount: 100},
+		{Currency: "usd"},
+		{Amount: 100, Currency: "usd", Fee: 200},
+		{CaptureMethod: "later"},
+		{ReturnURL: "https://example.com"},
+	} {
+		if err := p.Validate(); err == nil {
+			t.Errorf("Validate(%+v) err = nil want an error", p)
+		}
+	}
+
+	if err := (&PaymentIntentCancelParams{Reason: "bored"}).Validate(); err == nil {
+		t.Error("Validate() err = nil want an invalid cancellation_reason")
+	}
+}
+
+func TestValidatePaymentMethod(t *testing.T) {
+	if err := (&PaymentMethodParams{Type: "card", Card: &PaymentMethodCardParams{Token: "tok_123"}}).Validate(); err != nil {
+		t.Errorf("Validate() err = %v want nil", err)
+	}
+
+	if err := (&PaymentMethodParams{Type: "card", Card: &PaymentMethodCardParams{Number: "4242424242424242"}}).Validate(); err == nil {
+		t.Error("Validate() err = nil want missing expiry")
+	}
+
+	if err := (&PaymentMethodAttachParams{}).Validate(); err == nil {
+		t.Error("Validate() err = nil want a missing customer")
+	}
+
+	if err := (&PaymentMethodListParams{Customer: "cus_123"}).Validate(); err == nil {
+		t.Error("Validate() err = nil want a missing type")
+	}
+
+	if err := (&SetupIntentParams{Usage: "sometimes"}).Validate(); err == nil {
+		t.Error("Validate() err = nil want an invalid usage")
+	}
+}
+
+func TestValidatePrice(t *testing.T) {
+	valid := []*PriceParams{
+		{Currency: "usd", Product: "prod_123", UnitAmount: 1000},
+		{Currency: "usd", Product: "prod_123", Recurring: &PriceRecurringParams{Interval: "month", IntervalCount: 3}},
+		{Currency: "usd", Product: "prod_123", BillingScheme: "tiered", TiersMode: "volume",
+			Tiers: []*PriceTierParams{{UpTo: 10, UnitAmount: 500}, {UpToInf: true, UnitAmount: 400}}},
+		{Nickname: "renamed"},
+	}
+
+	for _, p := range valid {
+		if err := p.Validate(); err != nil {
+			t.Errorf("Validate(%+v) err = %v want nil", p, err)
+		}
+	}
+
+	invalid := []*PriceParams{
+		{Currency: "usd"},
+		{Currency: "usd", Product: "prod_123", ProductData: &PriceProductDataParams{Name: "Seats"}},
+		{Currency: "usd", Product: "prod_123", Recurring: &PriceRecurringParams{Interval: "week", IntervalCount: 53}},
+		{Currency: "usd", Product: "prod_123", BillingScheme: "tiered", TiersMode: "volume",
+			Tiers: []*PriceTierParams{{UpTo: 10, UnitAmount: 500}, {UpTo: 20, UnitAmount: 400}}},
+		{Currency: "usd", Product: "prod_123", BillingScheme: "tiered", TiersMode: "volume",
+			Tiers: []*PriceTierParams{{UpTo: 10}, {UpTo: 5}, {UpToInf: true}}},
+		{Currency: "usd", Product: "prod_123", BillingScheme: "tiered", UnitAmount: 100,
+			Tiers: []*PriceTierParams{{UpToInf: true}}},
+		{Currency: "usd", Product: "prod_123", Tiers: []*PriceTierParams{{UpToInf: true}}},
+	}
+
+	for _, p := range invalid {
+		if err := p.Validate(); err == nil {
+			t.Errorf("Validate(%+v) err = nil want an error", p)
+		}
+	}
+
+	if err := (&ProductListParams{Active: true, Inactive: true}).Validate(); err == nil {
+		t.Error("Validate() err = nil want conflicting active filters")
+	}
+
+	if err := (&InvoiceItemParams{Customer: "cus_123", Price: "price_123", Amount: 100}).Validate(); err == nil {
+		t.Error("Validate() err = nil want a price together with an amount")
+	}
+}
+
+func TestValidateSubItems(t *testing.T) {
+	valid := &SubParams{Customer: "cus_123", Items: []*SubItemsParams{{Plan: "base"}, {Price: "price_addon", Quantity: 3}}}
+	if err := valid.Validate(); err != nil {
+		t.Errorf("Validate() err = %v want nil", err)
+	}
+
+	invalid := []Validator{
+		&SubParams{Customer: "cus_123", Plan: "base", Items: []*SubItemsParams{{Plan: "addon"}}},
+		&SubParams{Customer: "cus_123", Items: []*SubItemsParams{{Quantity: 2}}},
+		&SubParams{Customer: "cus_123", Items: []*SubItemsParams{{Deleted: true}}},
+		&SubParams{Customer: "cus_123", Items: []*SubItemsParams{{Plan: "base", Price: "price_123"}}},
+		&SubItemParams{Sub: "sub_123"},
+		&SubItemParams{Plan: "base", NoProrate: true, ProrationDate: 1430438400},
+		&SubItemListParams{},
+	}
+
+	for _, p := range invalid {
+		if err := p.Validate(); err == nil {
+			t.Errorf("Validate(%+v) err = nil want an error", p)
+		}
+	}
+}
+
+func TestValidateUsage(t *testing.T) {
+	metered := &PlanParams{ID: "api", Name: "API", Currency: "usd", Interval: "month", UsageType: "metered", AggregateUsage: "max"}
+	if err := metered.Validate(); err != nil {
+		t.Errorf("Validate() err = %v want nil", err)
+	}
+
+	invalid := []Validator{
+		&PlanParams{ID: "api", Name: "API", Currency: "usd", Interval: "month", AggregateUsage: "sum"},
+		&PlanParams{ID: "api", Name: "API", Currency: "usd", Interval: "month", UsageType: "metered", AggregateUsage: "avg"},
+		&UsageRecordParams{Quantity: 10, Timestamp: 1430438400},
+		&UsageRecordParams{SubItem: "si_123", Quantity: 10},
+		&UsageRecordParams{SubItem: "si_123", Timestamp: 1430438400, Action: "add"},
+		&UsageRecordSummaryListParams{},
+		&SubParams{Customer: "cus_123", Items: []*SubItemsParams{{ID: "si_123", ClearUsage: true}}},
+	}
+
+	for _, p := range invalid {
+		if err := p.Validate(); err == nil {
+			t.Errorf("Validate(%+v) err = nil want an error", p)
+		}
+	}
+}
+
+func TestValidateCheckoutSession(t *testing.T) {
+	urls := CheckoutSessionParams{SuccessURL: "https://example.com/success", CancelURL: "https://example.com/cancel"}
+
+	valid := []CheckoutSessionParams{urls, urls}
+	valid[0].LineItems = []*CheckoutSessionLineItemParams{{Price: "price_123", Quantity: 1}}
+	valid[1].Mode = "setup"
+
+	for _, p := range valid {
+		if err := p.Validate(); err != nil {
+			t.Errorf("Validate(%+v) err = %v want nil", p, err)
+		}
+	}
+
+	invalid := []CheckoutSessionParams{urls, urls, urls, urls}
+	invalid[0].Mode = "subscription"
+	invalid[1].Mode = "setup"
+	invalid[1].LineItems = []*CheckoutSessionLineItemParams{{Price: "price_123", Quantity: 1}}
+	invalid[2].LineItems = []*CheckoutSessionLineItemParams{{Price: "price_123"}}
+	invalid[3].LineItems = []*CheckoutSessionLineItemParams{{Price: "price_123", Quantity: 1}}
+	invalid[3].Customer, invalid[3].CustomerEmail = "cus_123", "jenny@example.com"
+
+	for _, p := range append(invalid, CheckoutSessionParams{Mode: "setup"}) {
+		if err := p.Validate(); err == nil {
+			t.Errorf("Validate(%+v) err = nil want an error", p)
+		}
+	}
+}
+
+func TestValidatePayout(t *testing.T) {
+	if err := (&PayoutParams{Amount: 1100, Currency: "usd", Method: "instant"}).Validate(); err != nil {
+		t.Errorf("Validate() err = %v want nil", err)
+	}
+
+	invalid := []Validator{
+		&PayoutParams{Amount: 1100},
+		&PayoutParams{Currency: "usd"},
+		&PayoutParams{Method: "express"},
+		&PayoutListParams{Status: "sent"},
+		&PayoutListParams{Arrival: 1430438400, ArrivalRange: &RangeParams{LesserThan: 1430438400}},
+		&TopupParams{Amount: 2000},
+		&TopupParams{Amount: 2000, Currency: "usd", Statement: "a top-up for the platform"},
+	}
+
+	for _, p := range invalid {
+		if err := p.Validate(); err == nil {
+			t.Errorf("Validate(%+v) err = nil want an error", p)
+		}
+	}
+}
+
+func TestValidateInvoiceStatus(t *testing.T) {
+	if err := (&InvoiceListParams{Status: "uncollectible"}).Validate(); err != nil {
+		t.Errorf("Validate() err = %v want nil", err)
+	}
+
+	invalid := []Validator{
+		&InvoiceListParams{Status: "closed"},
+		&InvoiceParams{AutoAdvance: true, NoAutoAdvance: true},
+		&InvoiceFinalizeParams{AutoAdvance: true, NoAutoAdvance: true},
+	}
+
+	for _, p := range invalid {
+		if err := p.Validate(); err == nil {
+			t.Errorf("Validate(%+v) err = nil want an error", p)
+		}
+	}
+}
+
+func TestValidateCreditNote(t *testing.T) {
+	valid := []Validator{
+		&CreditNoteParams{Invoice: "in_123", Amount: 500, CreditAmount: 500},
+		&CreditNoteParams{Invoice: "in_123", Lines: []*CreditNoteLineParams{
+			{Type: "invoice_line_item", InvoiceLine: "il_123", Amount: 500},
+			{Type: "custom_line_item", Desc: "Goodwill", Quantity: 1, UnitAmount: 500},
+		}},
+	}
+
+	for _, p := range valid {
+		if err := p.Validate(); err != nil {
+			t.Errorf("Validate(%+v) err = %v want nil", p, err)
+		}
+	}
+
+	invalid := []Validator{
+		&CreditNoteParams{Amount: 500},
+		&CreditNoteParams{Invoice: "in_123"},
+		&CreditNoteParams{Invoice: "in_123", Amount: 500, Reason: "changed_mind"},
+		&CreditNoteParams{Invoice: "in_123", Amount: 500, Refund: "re_123", RefundAmount: 500},
+		&CreditNoteParams{Invoice: "in_123", Lines: []*CreditNoteLineParams{{InvoiceLine: "il_123"}}},
+		&CreditNoteParams{Invoice: "in_123", Lines: []*CreditNoteLineParams{{Type: "invoice_line_item"}}},
+		&CreditNoteParams{Invoice: "in_123", Lines: []*CreditNoteLineParams{{Type: "custom_line_item", UnitAmount: 500}}},
+		&CreditNoteLineListParams{},
+	}
+
+	for _, p := range invalid {
+		if err := p.Validate(); err == nil {
+			t.Errorf("Validate(%+v) err = nil want an error", p)
+		}
+	}
+}
+
+func TestValidateTaxRate(t *testing.T) {
+	valid := []Validator{
+		&TaxRateParams{DisplayName: "GST", Percentage: 10, Inclusive: true},
+		&TaxRateParams{DisplayName: "Exempt", Exclusive: true},
+		&TaxRateUpdateParams{DisplayName: "Sales tax"},
+		&TaxRateUpdateParams{},
+		&TaxRateListParams{Active: true, Exclusive: true},
+		&TaxIDParams{Customer: "cus_123", Type: "eu_vat", Value: "DE123456789"},
+		&SubParams{Customer: "cus_123", DefaultTaxRates: []string{"txr_123"}},
+	}
+
+	for _, p := range valid {
+		if err := p.Validate(); err != nil {
+			t.Errorf("Validate(%+v) err = %v want nil", p, err)
+		}
+	}
+
+	invalid := []Validator{
+		&TaxRateParams{DisplayName: "GST", Percentage: 10},
+		&TaxRateParams{Percentage: 10, Inclusive: true},
+		&TaxRateParams{DisplayName: "GST", Percentage: 110, Inclusive: true},
+		&TaxRateParams{DisplayName: "GST", Percentage: 10, Inclusive: true, Exclusive: true},
+		&TaxRateParams{},
+		&TaxRateUpdateParams{DisplayName: strings.Repeat("x", 51)},
+		&TaxRateListParams{Active: true, Inactive: true},
+		&TaxIDParams{Type: "eu_vat", Value: "DE123456789"},
+		&TaxIDParams{Customer: "cus_123", Type: "eu_vat"},
+		&TaxIDListParams{},
+		&SubParams{Customer: "cus_123", TaxPercent: 20, DefaultTaxRates: []string{"txr_123"}},
+		&InvoiceParams{TaxPercent: 20, DefaultTaxRates: []string{"txr_123"}},
+	}
+
+	for _, p := range invalid {
+		if err := p.Validate(); err == nil {
+			t.Errorf("Validate(%+v) err = nil want an error", p)
+		}
+	}
+}